@@ -0,0 +1,250 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// WithAuditLog appends a JSON line to the file at path for every mutating (POST, PUT, PATCH, DELETE) request
+// once it completed, after retries. The file is created if needed and shared by all clients logging to it. It is
+// opened for each entry, so it is never left open. Failing writes are logged as errors.
+func WithAuditLog(path string) Option {
+	return func(o *options) {
+		o.auditLogPath = path
+	}
+}
+
+// AuditEntry is a line of the audit log. Request bodies are only recorded as a digest, with secrets redacted first.
+type AuditEntry struct {
+	Timestamp  time.Time `json:"timestamp"`
+	Method     string    `json:"method"`
+	Path       string    `json:"path"`
+	Status     int       `json:"status"`
+	DurationMs int64     `json:"duration_ms"`
+	RequestID  string    `json:"request_id,omitempty"`
+	BodyDigest string    `json:"body_digest,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// auditLog serializes the writes to a file. The file is opened for each entry, so no file handle outlives a write.
+type auditLog struct {
+	mu   sync.Mutex
+	path string
+}
+
+var (
+	auditLogsMu sync.Mutex
+	auditLogs   = map[string]*auditLog{}
+)
+
+// openAuditLog returns the audit log for path, so that parallel clients serialize their writes to the same file.
+// The file is created if needed, to report an unusable path when the client is built.
+func openAuditLog(path string) (*auditLog, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	auditLogsMu.Lock()
+	defer auditLogsMu.Unlock()
+
+	if l, ok := auditLogs[path]; ok {
+		return l, nil
+	}
+
+	file, err := openAuditFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open audit log: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return nil, fmt.Errorf("unable to open audit log: %w", err)
+	}
+
+	l := &auditLog{path: path}
+	auditLogs[path] = l
+	return l, nil
+}
+
+func openAuditFile(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+}
+
+func (l *auditLog) write(entry AuditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	file, err := openAuditFile(l.path)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(append(line, '\n'))
+	return errors.Join(err, file.Close())
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// apply installs the hooks writing the audit log. The start of the first attempt is tracked per request, so the
+// duration includes retries.
+func (l *auditLog) apply(c *resty.Client) {
+	var started sync.Map
+
+	record := func(r *resty.Request, resp *resty.Response, err error) {
+		start, ok := started.LoadAndDelete(r)
+		if !ok {
+			return
+		}
+
+		entry := AuditEntry{
+			Timestamp:  start.(time.Time).UTC(),
+			Method:     r.Method,
+			Path:       r.URL,
+			DurationMs: time.Since(start.(time.Time)).Milliseconds(),
+			RequestID:  r.Header.Get("X-Request-ID"),
+			BodyDigest: bodyDigest(r.Body),
+		}
+		if r.RawRequest != nil {
+			entry.Path = r.RawRequest.URL.Path
+		}
+		if resp != nil && resp.RawResponse != nil {
+			entry.Status = resp.StatusCode()
+			if requestID := resp.Header().Get("X-Request-ID"); requestID != "" {
+				entry.RequestID = requestID
+			}
+		}
+		if err != nil {
+			entry.Error = err.Error()
+		}
+
+		if err := l.write(entry); err != nil {
+			newLogger(r.Context()).Error("Unable to write audit log", map[string]interface{}{
+				"audit_log_path": l.path,
+				"error":          err.Error(),
+			})
+		}
+	}
+
+	c.OnBeforeRequest(func(c *resty.Client, r *resty.Request) error {
+		if isMutating(r.Method) {
+			started.LoadOrStore(r, time.Now())
+		}
+		return nil
+	})
+	c.OnSuccess(func(c *resty.Client, resp *resty.Response) {
+		record(resp.Request, resp, nil)
+	})
+	c.OnError(func(r *resty.Request, err error) {
+		var responseErr *resty.ResponseError
+		if errors.As(err, &responseErr) {
+			record(r, responseErr.Response, responseErr.Err)
+			return
+		}
+		record(r, nil, err)
+	})
+	c.OnInvalid(func(r *resty.Request, err error) {
+		record(r, nil, err)
+	})
+}
+
+// bodyDigest returns the SHA-256 digest of the request body with secrets redacted
+func bodyDigest(body interface{}) string {
+	raw := requestBody(body)
+	if len(raw) == 0 {
+		return ""
+	}
+
+	digest := sha256.Sum256(redactBody(raw))
+	return "sha256:" + hex.EncodeToString(digest[:])
+}
+
+// requestBody returns the request body as sent, or nil for streamed bodies, e.g. file uploads, which can't be
+// read again
+func requestBody(body interface{}) []byte {
+	switch b := body.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return b
+	case string:
+		return []byte(b)
+	case io.Reader:
+		return nil
+	default:
+		raw, err := json.Marshal(b)
+		if err != nil {
+			return nil
+		}
+		return raw
+	}
+}
+
+// redactBody replaces the values of sensitive fields in a JSON body. Other bodies are returned unchanged.
+func redactBody(body []byte) []byte {
+	var document interface{}
+	if err := json.Unmarshal(body, &document); err != nil {
+		return body
+	}
+
+	var redacted bytes.Buffer
+	encoder := json.NewEncoder(&redacted)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redactValue(document)); err != nil {
+		return body
+	}
+	return bytes.TrimSuffix(redacted.Bytes(), []byte("\n"))
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if isSensitive(key) {
+				v[key] = "<REDACTED>"
+				continue
+			}
+			v[key] = redactValue(field)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
@@ -0,0 +1,226 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func readAuditLog(t *testing.T, path string) []AuditEntry {
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer file.Close()
+
+	var entries []AuditEntry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("invalid audit log line %q: %s", scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestWithAuditLog(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-ID", "req-1")
+		// the first PUT attempt fails transiently
+		if r.Method == http.MethodPut && attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	policy := DefaultRetryPolicy()
+	policy.MinWait = time.Millisecond
+	policy.MaxWait = time.Millisecond
+
+	restyClient, err := Build(server.URL, "test", WithAuditLog(path), WithRetryPolicy(policy))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := restyClient.R().Get("/api/repositories/foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := restyClient.R().SetBody(map[string]string{"key": "foo", "password": "secret"}).Put("/api/repositories/foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := restyClient.R().Delete("/api/repositories/bar"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	entries := readAuditLog(t, path)
+	if len(entries) != 2 {
+		t.Fatalf("Incorrect number of audit log entries. Expected 2: got: %v", entries)
+	}
+
+	put := entries[0]
+	if put.Method != http.MethodPut || put.Path != "/api/repositories/foo" || put.Status != http.StatusOK || put.RequestID != "req-1" {
+		t.Errorf("Incorrect audit log entry: %+v", put)
+	}
+	expectedDigest := bodyDigest(map[string]string{"key": "foo", "password": "other secret"})
+	if put.BodyDigest != expectedDigest {
+		t.Errorf("Incorrect body digest. Expected %s: got: %s", expectedDigest, put.BodyDigest)
+	}
+
+	del := entries[1]
+	if del.Method != http.MethodDelete || del.Status != http.StatusNotFound || del.BodyDigest != "" {
+		t.Errorf("Incorrect audit log entry: %+v", del)
+	}
+}
+
+func TestWithAuditLog_concurrent(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	// clients of different provider aliases share the file
+	clients := 2
+	requests := 50
+	var wg sync.WaitGroup
+	for c := 0; c < clients; c++ {
+		restyClient, err := Build(server.URL, "test", WithAuditLog(path))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		for i := 0; i < requests; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				restyClient.R().SetBody(map[string]int{"i": i}).Post(fmt.Sprintf("/api/items/%d", i))
+			}(i)
+		}
+	}
+	wg.Wait()
+
+	if entries := readAuditLog(t, path); len(entries) != clients*requests {
+		t.Errorf("Incorrect number of audit log entries. Expected %d: got: %d", clients*requests, len(entries))
+	}
+}
+
+func TestWithAuditLog_writeFailure(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	restyClient, err := Build(server.URL, "test", WithAuditLog(path))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// the file isn't kept open, so removing it, e.g. by log rotation, starts a new one
+	if err := os.Remove(path); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := restyClient.R().Post("/api/items"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if entries := readAuditLog(t, path); len(entries) != 1 {
+		t.Fatalf("Incorrect number of audit log entries. Expected 1: got: %v", entries)
+	}
+
+	if err := os.Remove(path); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := os.Mkdir(path, 0700); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	if _, err := restyClient.R().SetContext(ctx).Post("/api/items"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var logged bool
+	for _, entry := range entries {
+		if entry["@message"] == "Unable to write audit log" && entry["@level"] == "error" && entry["audit_log_path"] == path {
+			logged = true
+		}
+	}
+	if !logged {
+		t.Errorf("expected audit log write error to be logged: got: %v", entries)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		body     string
+		expected string
+	}{
+		"nested fields": {
+			body:     `{"key":"foo","Password":"secret","nested":[{"access_token":"secret","name":"bar"}]}`,
+			expected: `{"Password":"<REDACTED>","key":"foo","nested":[{"access_token":"<REDACTED>","name":"bar"}]}`,
+		},
+		"suffixes": {
+			body:     `{"id_token":"secret","refresh_token":"secret","clientSecret":"secret","newPassword":"secret","token_expiry":"foo"}`,
+			expected: `{"clientSecret":"<REDACTED>","id_token":"<REDACTED>","newPassword":"<REDACTED>","refresh_token":"<REDACTED>","token_expiry":"foo"}`,
+		},
+		"not JSON": {
+			body:     `password=secret`,
+			expected: `password=secret`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if redacted := string(redactBody([]byte(testCase.body))); redacted != testCase.expected {
+				t.Errorf("Incorrect redacted body. Expected %s: got: %s", testCase.expected, redacted)
+			}
+		})
+	}
+}
@@ -16,8 +16,8 @@ package client
 
 import (
 	"fmt"
+	"net/http"
 	"net/url"
-	"os"
 	"regexp"
 	"slices"
 	"strings"
@@ -25,42 +25,172 @@ import (
 	"github.com/go-resty/resty/v2"
 )
 
-func Build(URL, productId string) (*resty.Client, error) {
-	u, err := url.ParseRequestURI(URL)
+type options struct {
+	retryPolicy           RetryPolicy
+	requestsPerSecond     float64
+	maxConcurrentRequests int
+	tlsConfig             *TLSConfig
+	proxyConfig           *ProxyConfig
+	auditLogPath          string
+	logBodyLimit          int
+}
+
+// Option customizes the client created by Build
+type Option func(*options)
 
+// Build creates a resty client for the JFrog platform at URL. Any path in URL is kept as a base path prefix so
+// that platforms served behind a reverse proxy (e.g. https://gateway.example.com/jfrog/) are reachable.
+func Build(URL, productId string, opts ...Option) (*resty.Client, error) {
+	baseUrl, err := parseBaseURL(URL)
 	if err != nil {
 		return nil, err
 	}
 
-	baseUrl := fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+	o := options{
+		retryPolicy:  DefaultRetryPolicy(),
+		logBodyLimit: DefaultLogBodyLimit,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if err := o.retryPolicy.Validate(); err != nil {
+		return nil, err
+	}
+
+	if o.requestsPerSecond < 0 {
+		return nil, fmt.Errorf("max requests per second must not be negative, got %v", o.requestsPerSecond)
+	}
+
+	if o.maxConcurrentRequests < 0 {
+		return nil, fmt.Errorf("max concurrent requests must not be negative, got %d", o.maxConcurrentRequests)
+	}
+
+	if o.logBodyLimit < 0 {
+		return nil, fmt.Errorf("log body limit must not be negative, got %d", o.logBodyLimit)
+	}
 
 	restyBase := resty.New().
 		SetBaseURL(baseUrl).
-		SetDebug(strings.ToLower(os.Getenv("TF_LOG")) == "debug").
-		OnBeforeRequest(func(c *resty.Client, r *resty.Request) error {
-			tfLogLevel := strings.ToLower(os.Getenv("TF_LOG"))
-			if slices.Contains([]string{"debug", "trace"}, tfLogLevel) {
-				r.SetDebug(true)
-			}
-			return nil
-		}).
-		OnRequestLog(func(log *resty.RequestLog) error {
-			// Never log auth token
-			if log.Header.Get("Authorization") != "" {
-				log.Header.Set("Authorization", "<REDACTED>")
-			}
-			return nil
-		}).
 		SetHeader("content-type", "application/json").
 		SetHeader("accept", "*/*").
-		SetHeader("user-agent", "jfrog/"+productId).
-		SetRetryCount(20)
+		SetHeader("user-agent", "jfrog/"+productId)
+
+	o.retryPolicy.apply(restyBase)
+	applyCorrelation(restyBase)
+	applyLogging(restyBase, o.logBodyLimit)
+
+	if o.tlsConfig != nil {
+		tlsConfig, err := o.tlsConfig.build()
+		if err != nil {
+			return nil, err
+		}
+		restyBase.SetTLSClientConfig(tlsConfig)
+	}
+
+	if o.proxyConfig != nil {
+		proxy, err := o.proxyConfig.proxyFunc()
+		if err != nil {
+			return nil, err
+		}
+
+		transport, ok := restyBase.GetClient().Transport.(*http.Transport)
+		if !ok {
+			return nil, fmt.Errorf("unable to set proxy: unexpected transport type %T", restyBase.GetClient().Transport)
+		}
+		transport.Proxy = proxy
+	}
+
+	if o.requestsPerSecond > 0 || o.maxConcurrentRequests > 0 {
+		restyBase.SetTransport(newThrottledTransport(restyBase.GetClient().Transport, o.requestsPerSecond, o.maxConcurrentRequests))
+	}
+
+	if o.auditLogPath != "" {
+		auditLog, err := openAuditLog(o.auditLogPath)
+		if err != nil {
+			return nil, err
+		}
+		auditLog.apply(restyBase)
+	}
 
 	restyBase.DisableWarn = true
 
 	return restyBase, nil
 }
 
+// sensitiveHeaders are never logged
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"X-JFrog-Art-Api",
+}
+
+// sensitiveFields are request body fields never logged
+var sensitiveFields = []string{
+	"password",
+	"access_token",
+	"refresh_token",
+	"subject_token",
+	"id_token",
+	"token",
+	"apiKey",
+	"api_key",
+	"secret",
+	"client_secret",
+	"private_key",
+	"passphrase",
+}
+
+// sensitiveSuffixes are suffixes of header and body field names never logged, e.g. subject_token, id_token or
+// newPassword
+var sensitiveSuffixes = []string{
+	"token",
+	"secret",
+	"password",
+}
+
+// isSensitive reports whether the header or body field name holds a secret
+func isSensitive(name string) bool {
+	lowerName := strings.ToLower(name)
+	return slices.ContainsFunc(sensitiveHeaders, func(s string) bool { return strings.EqualFold(s, name) }) ||
+		slices.ContainsFunc(sensitiveFields, func(s string) bool { return strings.EqualFold(s, name) }) ||
+		slices.ContainsFunc(sensitiveSuffixes, func(s string) bool { return strings.HasSuffix(lowerName, s) })
+}
+
+// redactHeaders replaces the values of sensitive headers in header
+func redactHeaders(header http.Header) {
+	for name := range header {
+		if isSensitive(name) && header.Get(name) != "" {
+			header.Set(name, "<REDACTED>")
+		}
+	}
+}
+
+// parseBaseURL returns URL as scheme://host[/path] without trailing slash. Resty joins this with request paths,
+// so absolute paths such as "/artifactory/api/system/version" end up under the base path prefix.
+func parseBaseURL(URL string) (string, error) {
+	u, err := url.Parse(URL)
+	if err != nil {
+		return "", err
+	}
+
+	if u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("invalid URL '%s': scheme and host are required", URL)
+	}
+
+	if u.RawQuery != "" || u.ForceQuery {
+		return "", fmt.Errorf("invalid URL '%s': query string is not supported", URL)
+	}
+
+	if u.Fragment != "" || strings.Contains(URL, "#") {
+		return "", fmt.Errorf("invalid URL '%s': fragment is not supported", URL)
+	}
+
+	basePath := strings.TrimRight(u.EscapedPath(), "/")
+
+	return fmt.Sprintf("%s://%s%s", u.Scheme, u.Host, basePath), nil
+}
+
 func AddAuth(client *resty.Client, apiKey, accessToken string) (*resty.Client, error) {
 	if accessToken != "" {
 		return client.SetAuthToken(accessToken), nil
@@ -74,9 +204,18 @@ func AddAuth(client *resty.Client, apiKey, accessToken string) (*resty.Client, e
 var mergeAndSaveRegex = regexp.MustCompile(".*Could not merge and save new descriptor.*")
 
 func RetryOnMergeError(response *resty.Response, _r error) bool {
+	if response == nil {
+		return false
+	}
 	return mergeAndSaveRegex.MatchString(string(response.Body()[:]))
 }
 
+// NeverRetry disables retries for a request, including the ones from the client RetryPolicy, when added with
+// resty.Request.AddRetryCondition.
 func NeverRetry(response *resty.Response, err error) bool {
+	// Request retry conditions are evaluated before the client ones, so mark the request for the RetryPolicy to skip
+	if response != nil && response.Request != nil {
+		disableRetry(response.Request)
+	}
 	return false
 }
@@ -0,0 +1,103 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBuild_baseURL(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"https://myinstance.jfrog.io":              "https://myinstance.jfrog.io",
+		"https://myinstance.jfrog.io/":             "https://myinstance.jfrog.io",
+		"https://gw.example.com/jfrog":             "https://gw.example.com/jfrog",
+		"https://gw.example.com/jfrog/":            "https://gw.example.com/jfrog",
+		"http://localhost:8082/a/b//":              "http://localhost:8082/a/b",
+		"https://gw.example.com/my%20jfrog/":       "https://gw.example.com/my%20jfrog",
+		"https://user@gw.example.com:8443/jfrog//": "https://gw.example.com:8443/jfrog",
+	}
+
+	for url, expected := range testCases {
+		url, expected := url, expected
+		t.Run(url, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := Build(url, "test")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if c.BaseURL != expected {
+				t.Errorf("Incorrect base URL. Expected %s: got: %s", expected, c.BaseURL)
+			}
+		})
+	}
+}
+
+func TestBuild_invalidURL(t *testing.T) {
+	t.Parallel()
+
+	invalidURLs := []string{
+		"",
+		"myinstance.jfrog.io",
+		"/jfrog",
+		"https://gw.example.com/jfrog?foo=bar",
+		"https://gw.example.com/jfrog?",
+		"https://gw.example.com/jfrog#section",
+		"https://gw.example.com/jfrog#",
+	}
+
+	for _, url := range invalidURLs {
+		url := url
+		t.Run(url, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := Build(url, "test"); err == nil {
+				t.Errorf("expected error for URL %s, got no error", url)
+			}
+		})
+	}
+}
+
+func TestBuild_requestPathPrefix(t *testing.T) {
+	var requestPaths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestPaths = append(requestPaths, r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c, err := Build(server.URL+"/jfrog/", "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, path := range []string{"/artifactory/api/system/version", "artifactory/api/system/usage"} {
+		if _, err := c.R().Get(path); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	expected := []string{"/jfrog/artifactory/api/system/version", "/jfrog/artifactory/api/system/usage"}
+	for i, path := range expected {
+		if requestPaths[i] != path {
+			t.Errorf("Incorrect request path. Expected %s: got: %s", path, requestPaths[i])
+		}
+	}
+}
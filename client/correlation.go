@@ -0,0 +1,86 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"regexp"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	RequestIDHeader   = "X-Request-ID"
+	TraceparentHeader = "traceparent"
+)
+
+// traceparentRegex matches a W3C Trace Context traceparent header, e.g.
+// 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01
+var traceparentRegex = regexp.MustCompile(`^[0-9a-f]{2}-([0-9a-f]{32})-[0-9a-f]{16}-[0-9a-f]{2}$`)
+
+// randRead is replaced in tests
+var randRead = rand.Read
+
+var fallbackIDCounter atomic.Uint64
+
+// randomHex returns n random bytes, up to 32, hex encoded. If crypto/rand fails, the bytes are derived from the
+// process, the time and a counter instead, which keeps them unique.
+func randomHex(n int) string {
+	b := make([]byte, n)
+	if _, err := randRead(b); err != nil {
+		seed := fmt.Sprintf("%d-%d-%d", os.Getpid(), time.Now().UnixNano(), fallbackIDCounter.Add(1))
+		digest := sha256.Sum256([]byte(seed))
+		copy(b, digest[:])
+	}
+	return hex.EncodeToString(b)
+}
+
+// RequestID returns the correlation ID sent with the request
+func RequestID(r *resty.Request) string {
+	if r == nil {
+		return ""
+	}
+	return r.Header.Get(RequestIDHeader)
+}
+
+// applyCorrelation installs the hook which sets the X-Request-ID and W3C traceparent headers of each request. The
+// request ID is the trace ID, so both identify the logical operation on the server side. Headers set by the caller
+// are kept, and retries reuse the headers of the first attempt.
+func applyCorrelation(c *resty.Client) {
+	c.OnBeforeRequest(func(c *resty.Client, r *resty.Request) error {
+		traceparent := r.Header.Get(TraceparentHeader)
+		traceID := ""
+		if matches := traceparentRegex.FindStringSubmatch(traceparent); matches != nil {
+			traceID = matches[1]
+		}
+
+		if traceID == "" {
+			traceID = randomHex(16)
+			r.Header.Set(TraceparentHeader, fmt.Sprintf("00-%s-%s-01", traceID, randomHex(8)))
+		}
+
+		if r.Header.Get(RequestIDHeader) == "" {
+			r.Header.Set(RequestIDHeader, traceID)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,187 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCorrelation_keptAcrossRetries(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var requestIDs, traceparents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requestIDs = append(requestIDs, r.Header.Get(RequestIDHeader))
+		traceparents = append(traceparents, r.Header.Get(TraceparentHeader))
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	policy := DefaultRetryPolicy()
+	policy.MaxAttempts = 3
+	policy.MinWait = time.Millisecond
+	policy.MaxWait = time.Millisecond
+
+	restyClient, err := Build(server.URL, "test", WithRetryPolicy(policy))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := restyClient.R().Get("/api/repositories/foo")
+	err = CheckResponse(resp, err)
+	if err == nil {
+		t.Fatal("expected error, got no error")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(requestIDs) != 3 {
+		t.Fatalf("Incorrect number of attempts. Expected 3: got: %d", len(requestIDs))
+	}
+	for i := range requestIDs {
+		if requestIDs[i] != requestIDs[0] || traceparents[i] != traceparents[0] {
+			t.Errorf("Incorrect headers of attempt %d. Expected %s, %s: got: %s, %s", i+1, requestIDs[0], traceparents[0], requestIDs[i], traceparents[i])
+		}
+	}
+
+	matches := traceparentRegex.FindStringSubmatch(traceparents[0])
+	if matches == nil || matches[1] != requestIDs[0] {
+		t.Errorf("Incorrect traceparent. Expected trace ID %s: got: %s", requestIDs[0], traceparents[0])
+	}
+
+	if !strings.Contains(err.Error(), "(request ID: "+requestIDs[0]+")") {
+		t.Errorf("Incorrect error. Expected request ID %s: got: %s", requestIDs[0], err)
+	}
+}
+
+func TestCorrelation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		requestID         string
+		traceparent       string
+		expectedRequestID string
+	}{
+		"generated": {},
+		"caller traceparent": {
+			traceparent:       "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			expectedRequestID: "4bf92f3577b34da6a3ce929d0e0e4736",
+		},
+		"caller request ID": {
+			requestID:         "my-request",
+			expectedRequestID: "my-request",
+		},
+		"invalid caller traceparent": {
+			traceparent: "invalid",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var requestID, traceparent string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestID = r.Header.Get(RequestIDHeader)
+				traceparent = r.Header.Get(TraceparentHeader)
+			}))
+			defer server.Close()
+
+			restyClient, err := Build(server.URL, "test")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			req := restyClient.R()
+			if testCase.requestID != "" {
+				req.SetHeader(RequestIDHeader, testCase.requestID)
+			}
+			if testCase.traceparent != "" {
+				req.SetHeader(TraceparentHeader, testCase.traceparent)
+			}
+			if _, err := req.Get("/api/repositories/foo"); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.expectedRequestID != "" && requestID != testCase.expectedRequestID {
+				t.Errorf("Incorrect request ID. Expected %s: got: %s", testCase.expectedRequestID, requestID)
+			}
+			if requestID == "" || !traceparentRegex.MatchString(traceparent) {
+				t.Errorf("Incorrect headers: %s, %s", requestID, traceparent)
+			}
+			if testCase.traceparent == "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01" && traceparent != testCase.traceparent {
+				t.Errorf("Incorrect traceparent. Expected %s: got: %s", testCase.traceparent, traceparent)
+			}
+		})
+	}
+}
+
+func TestRandomHex_randFailure(t *testing.T) {
+	randRead = func(b []byte) (int, error) {
+		return 0, errors.New("entropy unavailable")
+	}
+	defer func() { randRead = rand.Read }()
+
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		id := randomHex(16)
+		if len(id) != 32 || id == strings.Repeat("0", 32) {
+			t.Fatalf("Incorrect ID. Expected 32 non-zero hex digits: got: %s", id)
+		}
+		if seen[id] {
+			t.Fatalf("Incorrect ID. Expected unique IDs: got: %s twice", id)
+		}
+		seen[id] = true
+	}
+
+	traceparent := fmt.Sprintf("00-%s-%s-01", randomHex(16), randomHex(8))
+	if !traceparentRegex.MatchString(traceparent) {
+		t.Errorf("Incorrect traceparent: %s", traceparent)
+	}
+}
+
+func TestCheckResponse_requestIDOnTransportError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	policy := DefaultRetryPolicy()
+	policy.MaxAttempts = 1
+
+	restyClient, err := Build(url, "test", WithRetryPolicy(policy))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := restyClient.R().Get("/api/repositories/foo")
+	err = CheckResponse(resp, err)
+	if err == nil || !strings.Contains(err.Error(), "(request ID: "+RequestID(resp.Request)+")") || RequestID(resp.Request) == "" {
+		t.Errorf("Incorrect error. Expected request ID: got: %v", err)
+	}
+}
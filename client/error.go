@@ -0,0 +1,209 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/samber/lo"
+)
+
+type JFrogErrors struct {
+	Errors []JFrogError `json:"errors"`
+}
+
+func (e JFrogErrors) String() string {
+	return lo.Reduce(
+		e.Errors,
+		func(agg string, err JFrogError, _ int) string {
+			if agg == "" {
+				return err.Message
+			}
+
+			return fmt.Sprintf("%s %s.", agg, err.Message)
+		},
+		"",
+	)
+}
+
+type JFrogError struct {
+	Code    string `json:"code"`
+	Status  int    `json:"status,omitempty"`
+	Message string `json:"message"`
+}
+
+var (
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+)
+
+// requestIDHeaders are the response headers which may hold the platform request ID, in order of preference
+var requestIDHeaders = []string{
+	"X-JFrog-Request-Id",
+	"X-Request-Id",
+}
+
+// APIError is an error response from the JFrog Platform API. Use errors.Is with ErrNotFound, ErrConflict,
+// ErrForbidden or ErrUnauthorized, or the IsNotFound, IsConflict, IsForbidden and IsUnauthorized helpers, to
+// check for specific status codes.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	RequestID  string
+	Errors     []JFrogError
+}
+
+func (e *APIError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %s returned %d %s", e.Method, e.Path, e.StatusCode, http.StatusText(e.StatusCode))
+
+	messages := lo.FilterMap(e.Errors, func(err JFrogError, _ int) (string, bool) {
+		return err.Message, err.Message != ""
+	})
+	if len(messages) > 0 {
+		fmt.Fprintf(&sb, ": %s", strings.Join(messages, "; "))
+	}
+
+	if e.RequestID != "" {
+		fmt.Fprintf(&sb, " (request ID: %s)", e.RequestID)
+	}
+
+	return sb.String()
+}
+
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	}
+	return false
+}
+
+func IsUnauthorized(err error) bool { return errors.Is(err, ErrUnauthorized) }
+func IsForbidden(err error) bool    { return errors.Is(err, ErrForbidden) }
+func IsNotFound(err error) bool     { return errors.Is(err, ErrNotFound) }
+func IsConflict(err error) bool     { return errors.Is(err, ErrConflict) }
+
+// CheckResponse returns err when the request failed, an *APIError when the server returned an error response,
+// and nil otherwise. It replaces the usual err/resp.IsError() checks:
+//
+//	resp, err := client.R().Get("/artifactory/api/repositories/foo")
+//	if err := client.CheckResponse(resp, err); err != nil {
+//		if client.IsNotFound(err) {
+//			...
+//		}
+//		return err
+//	}
+func CheckResponse(resp *resty.Response, err error) error {
+	if err != nil {
+		if resp != nil {
+			if id := RequestID(resp.Request); id != "" {
+				return fmt.Errorf("%w (request ID: %s)", err, id)
+			}
+		}
+		return err
+	}
+
+	if resp == nil || !resp.IsError() {
+		return nil
+	}
+
+	return NewAPIError(resp)
+}
+
+// NewAPIError decodes the error response resp. The platform services return errors in one of the following
+// formats, all of which end up in APIError.Errors:
+//
+//	{"errors": [{"status": 404, "message": "..."}]}
+//	{"error": "..."} or {"error": "...", "error_description": "..."}
+//	{"message": "..."}
+//	plain text
+func NewAPIError(resp *resty.Response) *APIError {
+	apiError := &APIError{
+		StatusCode: resp.StatusCode(),
+		RequestID:  requestID(resp),
+		Errors:     decodeErrors(resp.Body()),
+	}
+
+	if resp.Request != nil {
+		apiError.Method = resp.Request.Method
+		apiError.Path = resp.Request.URL
+		if resp.Request.RawRequest != nil {
+			apiError.Path = resp.Request.RawRequest.URL.Path
+		}
+	}
+
+	return apiError
+}
+
+// requestID returns the request ID reported by the server, or else the one sent by the client
+func requestID(resp *resty.Response) string {
+	for _, name := range requestIDHeaders {
+		if id := resp.Header().Get(name); id != "" {
+			return id
+		}
+	}
+	return RequestID(resp.Request)
+}
+
+func decodeErrors(body []byte) []JFrogError {
+	body = []byte(strings.TrimSpace(string(body)))
+	if len(body) == 0 {
+		return nil
+	}
+
+	var decoded struct {
+		JFrogErrors
+		Error            json.RawMessage `json:"error"`
+		ErrorDescription string          `json:"error_description"`
+		Message          string          `json:"message"`
+	}
+
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return []JFrogError{{Message: string(body)}}
+	}
+
+	if len(decoded.Errors) > 0 {
+		return decoded.Errors
+	}
+
+	var errorMessage string
+	if len(decoded.Error) > 0 && json.Unmarshal(decoded.Error, &errorMessage) == nil && errorMessage != "" {
+		if decoded.ErrorDescription != "" {
+			return []JFrogError{{Code: errorMessage, Message: decoded.ErrorDescription}}
+		}
+		return []JFrogError{{Message: errorMessage}}
+	}
+
+	if decoded.Message != "" {
+		return []JFrogError{{Message: decoded.Message}}
+	}
+
+	return []JFrogError{{Message: string(body)}}
+}
@@ -0,0 +1,169 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestCheckResponse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		status         int
+		contentType    string
+		body           string
+		expectedErrors []JFrogError
+		expectedIs     error
+	}{
+		"errors array": {
+			status:         http.StatusNotFound,
+			contentType:    "application/json",
+			body:           `{"errors":[{"status":404,"message":"Repository foo not found"}]}`,
+			expectedErrors: []JFrogError{{Status: 404, Message: "Repository foo not found"}},
+			expectedIs:     ErrNotFound,
+		},
+		"errors array with code": {
+			status:         http.StatusConflict,
+			contentType:    "application/json",
+			body:           `{"errors":[{"code":"CONFLICT","message":"Project already exists"},{"code":"CONFLICT","message":"Key in use"}]}`,
+			expectedErrors: []JFrogError{{Code: "CONFLICT", Message: "Project already exists"}, {Code: "CONFLICT", Message: "Key in use"}},
+			expectedIs:     ErrConflict,
+		},
+		"error string": {
+			status:         http.StatusForbidden,
+			contentType:    "application/json",
+			body:           `{"error":"Insufficient permissions"}`,
+			expectedErrors: []JFrogError{{Message: "Insufficient permissions"}},
+			expectedIs:     ErrForbidden,
+		},
+		"oauth error": {
+			status:         http.StatusUnauthorized,
+			contentType:    "application/json",
+			body:           `{"error":"invalid_grant","error_description":"Token expired"}`,
+			expectedErrors: []JFrogError{{Code: "invalid_grant", Message: "Token expired"}},
+			expectedIs:     ErrUnauthorized,
+		},
+		"message": {
+			status:         http.StatusBadRequest,
+			contentType:    "application/json",
+			body:           `{"message":"Invalid request"}`,
+			expectedErrors: []JFrogError{{Message: "Invalid request"}},
+		},
+		"plain text": {
+			status:         http.StatusInternalServerError,
+			contentType:    "text/plain",
+			body:           "Something went wrong\n",
+			expectedErrors: []JFrogError{{Message: "Something went wrong"}},
+		},
+		"unknown json": {
+			status:         http.StatusBadRequest,
+			contentType:    "application/json",
+			body:           `{"foo":"bar"}`,
+			expectedErrors: []JFrogError{{Message: `{"foo":"bar"}`}},
+		},
+		"empty body": {
+			status:     http.StatusNotFound,
+			body:       "",
+			expectedIs: ErrNotFound,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", testCase.contentType)
+				w.Header().Set("X-Request-Id", "abc123")
+				w.WriteHeader(testCase.status)
+				fmt.Fprint(w, testCase.body)
+			}))
+			defer server.Close()
+
+			c, err := Build(server.URL, "test", WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			err = CheckResponse(c.R().Get("/access/api/v1/projects/foo"))
+
+			var apiError *APIError
+			if !errors.As(err, &apiError) {
+				t.Fatalf("expected *APIError, got: %v", err)
+			}
+
+			if apiError.StatusCode != testCase.status || apiError.Method != http.MethodGet || apiError.Path != "/access/api/v1/projects/foo" || apiError.RequestID != "abc123" {
+				t.Errorf("Incorrect error details: %+v", apiError)
+			}
+
+			if !reflect.DeepEqual(apiError.Errors, testCase.expectedErrors) {
+				t.Errorf("Incorrect errors. Expected %v: got: %v", testCase.expectedErrors, apiError.Errors)
+			}
+
+			for _, target := range []error{ErrNotFound, ErrConflict, ErrForbidden, ErrUnauthorized} {
+				if errors.Is(fmt.Errorf("wrapped: %w", err), target) != (target == testCase.expectedIs) {
+					t.Errorf("Incorrect errors.Is result for %v", target)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckResponse_success(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c, err := Build(server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := CheckResponse(c.R().Get("/test")); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	requestErr := errors.New("connection refused")
+	if err := CheckResponse(nil, requestErr); err != requestErr {
+		t.Errorf("Incorrect error. Expected %v: got: %v", requestErr, err)
+	}
+}
+
+func TestAPIError_Error(t *testing.T) {
+	t.Parallel()
+
+	err := &APIError{
+		StatusCode: http.StatusNotFound,
+		Method:     http.MethodGet,
+		Path:       "/artifactory/api/repositories/foo",
+		RequestID:  "abc123",
+		Errors:     []JFrogError{{Message: "Repository foo not found"}, {Message: "Try again"}},
+	}
+
+	expected := "GET /artifactory/api/repositories/foo returned 404 Not Found: Repository foo not found; Try again (request ID: abc123)"
+	if err.Error() != expected {
+		t.Errorf("Incorrect error message. Expected %s: got: %s", expected, err.Error())
+	}
+}
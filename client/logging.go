@@ -0,0 +1,208 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the tflog subsystem of the client request and response logs. Its level follows TF_LOG_PROVIDER
+// (or TF_LOG) and can be set on its own with TF_LOG_PROVIDER_JFROG_HTTP.
+const LogSubsystem = "jfrog_http"
+
+// DefaultLogBodyLimit is the number of bytes of request and response bodies logged by default
+const DefaultLogBodyLimit = 4096
+
+// WithLogBodyLimit truncates logged request and response bodies to limit bytes. Bodies are not logged if limit is 0.
+func WithLogBodyLimit(limit int) Option {
+	return func(o *options) {
+		o.logBodyLimit = limit
+	}
+}
+
+// maskedFieldKeys are the log field keys whose values are masked: sensitive headers and JSON fields
+func maskedFieldKeys() []string {
+	keys := make([]string, 0, len(sensitiveHeaders)+len(sensitiveFields))
+	for _, name := range sensitiveHeaders {
+		keys = append(keys, http.CanonicalHeaderKey(name))
+	}
+	return append(keys, sensitiveFields...)
+}
+
+// logger logs to the LogSubsystem of the context, or to the standard logger when the context has no provider
+// logger, e.g. context.Background() or requests without SetContext. Sensitive fields are masked either way.
+type logger struct {
+	ctx      context.Context
+	fallback bool
+}
+
+func newLogger(ctx context.Context) logger {
+	subsystemCtx := tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_JFROG_HTTP"))
+	// NewSubsystem returns ctx as is without provider logger
+	if subsystemCtx == ctx {
+		return logger{ctx: ctx, fallback: true}
+	}
+
+	return logger{ctx: tflog.SubsystemMaskFieldValuesWithFieldKeys(subsystemCtx, LogSubsystem, maskedFieldKeys()...)}
+}
+
+func (l logger) Debug(msg string, fields map[string]interface{}) {
+	if l.fallback {
+		logFallback("DEBUG", msg, fields)
+		return
+	}
+	tflog.SubsystemDebug(l.ctx, LogSubsystem, msg, fields)
+}
+
+func (l logger) Warn(msg string, fields map[string]interface{}) {
+	if l.fallback {
+		logFallback("WARN", msg, fields)
+		return
+	}
+	tflog.SubsystemWarn(l.ctx, LogSubsystem, msg, fields)
+}
+
+func (l logger) Error(msg string, fields map[string]interface{}) {
+	if l.fallback {
+		logFallback("ERROR", msg, fields)
+		return
+	}
+	tflog.SubsystemError(l.ctx, LogSubsystem, msg, fields)
+}
+
+// logFallback writes the message and its fields, sorted by key, to the standard logger
+func logFallback(level string, msg string, fields map[string]interface{}) {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	masked := maskedFieldKeys()
+	var line strings.Builder
+	for _, key := range keys {
+		value := fields[key]
+		if slices.Contains(masked, key) {
+			value = "***"
+		}
+		fmt.Fprintf(&line, " %s=%v", key, value)
+	}
+
+	log.Printf("[%s] %s:%s", level, msg, line.String())
+}
+
+// logBody returns the body for logging, with sensitive JSON fields redacted and truncated to limit bytes
+func logBody(body []byte, limit int) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	if !utf8.Valid(body) {
+		return fmt.Sprintf("<binary, %d bytes>", len(body))
+	}
+
+	body = redactBody(body)
+	if len(body) <= limit {
+		return string(body)
+	}
+
+	truncated := body[:limit]
+	// don't split a multi-byte character
+	for len(truncated) > 0 && !utf8.Valid(truncated) {
+		truncated = truncated[:len(truncated)-1]
+	}
+	return fmt.Sprintf("%s... (truncated, %d bytes)", truncated, len(body))
+}
+
+// headerFields returns a log field per header, so that sensitive headers are masked by their field key
+func headerFields(fields map[string]interface{}, header http.Header) {
+	for name, values := range header {
+		fields[http.CanonicalHeaderKey(name)] = strings.Join(values, ", ")
+	}
+}
+
+// requestURL returns the URL of the request, which is only resolved against the base URL once it is sent
+func requestURL(c *resty.Client, r *resty.Request) string {
+	if r.RawRequest != nil {
+		return r.RawRequest.URL.String()
+	}
+	if strings.HasPrefix(r.URL, "http://") || strings.HasPrefix(r.URL, "https://") {
+		return r.URL
+	}
+	return strings.TrimSuffix(c.BaseURL, "/") + "/" + strings.TrimPrefix(r.URL, "/")
+}
+
+// applyLogging installs the hooks logging every request attempt and its response to the LogSubsystem of the
+// request context, see logger.
+func applyLogging(c *resty.Client, bodyLimit int) {
+	c.OnBeforeRequest(func(c *resty.Client, r *resty.Request) error {
+		fields := map[string]interface{}{
+			"http_method":     r.Method,
+			"http_url":        requestURL(c, r),
+			"http_attempt":    r.Attempt,
+			"http_request_id": RequestID(r),
+		}
+		headerFields(fields, c.Header)
+		headerFields(fields, r.Header)
+		if bodyLimit > 0 {
+			if body := logBody(requestBody(r.Body), bodyLimit); body != "" {
+				fields["http_body"] = body
+			}
+		}
+
+		newLogger(r.Context()).Debug("Sending HTTP request", fields)
+		return nil
+	})
+
+	c.OnAfterResponse(func(c *resty.Client, resp *resty.Response) error {
+		fields := map[string]interface{}{
+			"http_method":      resp.Request.Method,
+			"http_url":         requestURL(c, resp.Request),
+			"http_attempt":     resp.Request.Attempt,
+			"http_request_id":  RequestID(resp.Request),
+			"http_status":      resp.StatusCode(),
+			"http_duration_ms": resp.Time().Milliseconds(),
+		}
+		headerFields(fields, resp.Header())
+		if bodyLimit > 0 {
+			if body := logBody(resp.Body(), bodyLimit); body != "" {
+				fields["http_body"] = body
+			}
+		}
+
+		newLogger(resp.Request.Context()).Debug("Received HTTP response", fields)
+		return nil
+	})
+
+	c.OnError(func(r *resty.Request, err error) {
+		newLogger(r.Context()).Debug("HTTP request failed", map[string]interface{}{
+			"http_method":     r.Method,
+			"http_url":        requestURL(c, r),
+			"http_attempt":    r.Attempt,
+			"http_request_id": RequestID(r),
+			"error":           err.Error(),
+		})
+	})
+}
@@ -0,0 +1,217 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"response-secret","description":"` + strings.Repeat("x", 100) + `"}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	restyClient, err := Build(server.URL, "test", WithLogBodyLimit(64))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = restyClient.R().
+		SetContext(ctx).
+		SetHeader("X-JFrog-Art-Api", "header-secret").
+		SetBody(map[string]interface{}{"key": "foo", "nested": map[string]string{"password": "body-secret"}}).
+		Put("/api/repositories/foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Incorrect number of log entries. Expected 2: got: %v", entries)
+	}
+
+	request, response := entries[0], entries[1]
+	if request["@module"] != "provider."+LogSubsystem || request["http_method"] != http.MethodPut || request["http_url"] != server.URL+"/api/repositories/foo" {
+		t.Errorf("Incorrect request log entry: %v", request)
+	}
+	if request["X-Jfrog-Art-Api"] != "***" {
+		t.Errorf("Incorrect X-JFrog-Art-Api header. Expected ***: got: %v", request["X-Jfrog-Art-Api"])
+	}
+	if !strings.Contains(request["http_body"].(string), `"password":"<REDACTED>"`) {
+		t.Errorf("Incorrect request body: %v", request["http_body"])
+	}
+
+	if request["http_request_id"] == "" || response["http_request_id"] != request["http_request_id"] {
+		t.Errorf("Incorrect request ID. Expected %v: got: %v", request["http_request_id"], response["http_request_id"])
+	}
+
+	if response["http_status"] != float64(http.StatusOK) {
+		t.Errorf("Incorrect status. Expected 200: got: %v", response["http_status"])
+	}
+	body := response["http_body"].(string)
+	if !strings.HasPrefix(body, `{"access_token":"<REDACTED>"`) || !strings.HasSuffix(body, "(truncated, 146 bytes)") {
+		t.Errorf("Incorrect response body: %v", body)
+	}
+
+	if strings.Contains(output.String(), "secret") {
+		t.Errorf("Secrets were logged: %s", output.String())
+	}
+}
+
+func TestLogging_withoutProviderLogger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	log.SetOutput(&output)
+	defer log.SetOutput(os.Stderr)
+
+	restyClient, err := Build(server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// no SetContext
+	_, err = restyClient.R().
+		SetHeader("X-JFrog-Art-Api", "header-secret").
+		SetBody(map[string]string{"key": "foo", "password": "body-secret"}).
+		Put("/api/repositories/foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	logged := output.String()
+	for _, expected := range []string{"[DEBUG] Sending HTTP request:", "[DEBUG] Received HTTP response:", "X-Jfrog-Art-Api=***", `"password":"<REDACTED>"`} {
+		if !strings.Contains(logged, expected) {
+			t.Errorf("Incorrect log output. Expected %s in: %s", expected, logged)
+		}
+	}
+	if strings.Contains(logged, "secret") {
+		t.Errorf("Incorrect log output. Expected secrets to be masked: %s", logged)
+	}
+}
+
+func TestLogging_retry(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	policy := DefaultRetryPolicy()
+	policy.MinWait = time.Millisecond
+	policy.MaxWait = time.Millisecond
+	restyClient, err := Build(server.URL, "test", WithRetryPolicy(policy))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := restyClient.R().SetContext(ctx).Get("/api/repositories"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var retries int
+	for _, entry := range entries {
+		if entry["@message"] == "Retrying HTTP request" {
+			retries++
+			if entry["@module"] != "provider."+LogSubsystem || entry["http_retry_reason"] != "503 Service Unavailable" {
+				t.Errorf("Incorrect retry log entry: %v", entry)
+			}
+		}
+	}
+	if retries != 1 {
+		t.Errorf("Incorrect number of retry log entries. Expected 1: got: %d", retries)
+	}
+}
+
+func TestLogBody(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		body     []byte
+		limit    int
+		expected string
+	}{
+		"empty": {
+			body:     nil,
+			limit:    10,
+			expected: "",
+		},
+		"within limit": {
+			body:     []byte(`{"key":"foo"}`),
+			limit:    100,
+			expected: `{"key":"foo"}`,
+		},
+		"truncated": {
+			body:     []byte("0123456789"),
+			limit:    4,
+			expected: "0123... (truncated, 10 bytes)",
+		},
+		"multi-byte character": {
+			body:     []byte("aé"),
+			limit:    2,
+			expected: "a... (truncated, 3 bytes)",
+		},
+		"OIDC token exchange": {
+			body:     []byte(`{"grant_type":"urn:ietf:params:oauth:grant-type:token-exchange","subject_token_type":"urn:ietf:params:oauth:token-type:id_token","subject_token":"SECRET_ID_TOKEN","provider_name":"test"}`),
+			limit:    4096,
+			expected: `{"grant_type":"urn:ietf:params:oauth:grant-type:token-exchange","provider_name":"test","subject_token":"<REDACTED>","subject_token_type":"urn:ietf:params:oauth:token-type:id_token"}`,
+		},
+		"binary": {
+			body:     []byte{0xff, 0xfe, 0x00},
+			limit:    10,
+			expected: "<binary, 3 bytes>",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if body := logBody(testCase.body, testCase.limit); body != testCase.expected {
+				t.Errorf("Incorrect body. Expected %s: got: %s", testCase.expected, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// ProxyConfig holds an explicit proxy for the client. When set, proxy environment variables such as
+// HTTPS_PROXY and NO_PROXY are ignored.
+type ProxyConfig struct {
+	// URL of the proxy, e.g. http://proxy.example.com:3128. Supported schemes are http, https and socks5.
+	URL      string
+	Username string
+	Password string
+	// NoProxy lists hosts which are reached directly, using the NO_PROXY format: host names, domain
+	// suffixes (.example.com), IP addresses, CIDR ranges, optionally with a port, or * for all hosts.
+	NoProxy []string
+}
+
+// WithProxy sends the client requests through an explicit proxy.
+func WithProxy(config ProxyConfig) Option {
+	return func(o *options) {
+		o.proxyConfig = &config
+	}
+}
+
+func (c ProxyConfig) proxyFunc() (func(*http.Request) (*url.URL, error), error) {
+	proxyURL, err := url.Parse(c.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL: %w", err)
+	}
+
+	if proxyURL.Host == "" || !(proxyURL.Scheme == "http" || proxyURL.Scheme == "https" || proxyURL.Scheme == "socks5") {
+		return nil, fmt.Errorf("invalid proxy URL '%s': must have a host and an http, https or socks5 scheme", proxyURL.Redacted())
+	}
+
+	if c.Username != "" {
+		proxyURL.User = url.UserPassword(c.Username, c.Password)
+	} else if c.Password != "" {
+		return nil, fmt.Errorf("proxy password is set without proxy username")
+	}
+
+	config := httpproxy.Config{
+		HTTPProxy:  proxyURL.String(),
+		HTTPSProxy: proxyURL.String(),
+		NoProxy:    strings.Join(c.NoProxy, ","),
+	}
+	proxyForURL := config.ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyForURL(req.URL)
+	}, nil
+}
@@ -0,0 +1,144 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithProxy(t *testing.T) {
+	t.Parallel()
+
+	var proxyAuthorization, requestURL string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxyAuthorization = r.Header.Get("Proxy-Authorization")
+		requestURL = r.URL.String()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	c, err := Build("http://artifactory.example.com/jfrog", "test", WithProxy(ProxyConfig{
+		URL:      proxy.URL,
+		Username: "user",
+		Password: "p@ss",
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := c.R().Get("/artifactory/api/system/ping")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		t.Errorf("Incorrect status. Expected %d: got: %d", http.StatusOK, resp.StatusCode())
+	}
+
+	expectedURL := "http://artifactory.example.com/jfrog/artifactory/api/system/ping"
+	if requestURL != expectedURL {
+		t.Errorf("Incorrect proxied URL. Expected %s: got: %s", expectedURL, requestURL)
+	}
+
+	expectedAuthorization := "Basic " + base64.StdEncoding.EncodeToString([]byte("user:p@ss"))
+	if proxyAuthorization != expectedAuthorization {
+		t.Errorf("Incorrect proxy authorization. Expected %s: got: %s", expectedAuthorization, proxyAuthorization)
+	}
+}
+
+func TestProxyConfig_noProxy(t *testing.T) {
+	t.Parallel()
+
+	config := ProxyConfig{
+		URL:     "http://proxy.example.com:3128",
+		NoProxy: []string{".internal.example.com", "10.0.0.0/8", "direct.example.com:8443"},
+	}
+
+	proxy, err := config.proxyFunc()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]bool{
+		"https://myinstance.jfrog.io/artifactory":           true,
+		"https://jfrog.internal.example.com/artifactory":    false,
+		"http://10.1.2.3:8082/artifactory":                  false,
+		"https://direct.example.com:8443/artifactory":       false,
+		"https://direct.example.com/artifactory":            true,
+		"https://internal.example.com.evil.com/artifactory": true,
+	}
+
+	for target, expectProxy := range testCases {
+		req, err := http.NewRequest(http.MethodGet, target, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		proxyURL, err := proxy(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if (proxyURL != nil) != expectProxy {
+			t.Errorf("Incorrect proxy for %s. Expected proxy: %t, got: %v", target, expectProxy, proxyURL)
+		}
+	}
+}
+
+func TestProxyConfig_invalid(t *testing.T) {
+	t.Parallel()
+
+	invalidConfigs := map[string]ProxyConfig{
+		"no scheme":             {URL: "proxy.example.com:3128"},
+		"unsupported scheme":    {URL: "ftp://proxy.example.com"},
+		"password with no user": {URL: "http://proxy.example.com", Password: "secret"},
+	}
+
+	for name, config := range invalidConfigs {
+		config := config
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := Build("https://myinstance.jfrog.io", "test", WithProxy(config)); err == nil {
+				t.Error("expected error, got no error")
+			}
+		})
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	t.Parallel()
+
+	header := http.Header{}
+	header.Set("Authorization", "Bearer secret")
+	header.Set("Proxy-Authorization", "Basic secret")
+	header.Set("X-JFrog-Art-Api", "secret")
+	header.Set("Content-Type", "application/json")
+
+	redactHeaders(header)
+
+	for _, name := range sensitiveHeaders {
+		if header.Get(name) != "<REDACTED>" {
+			t.Errorf("Header %s was not redacted: %s", name, header.Get(name))
+		}
+	}
+
+	if header.Get("Content-Type") != "application/json" {
+		t.Errorf("Header Content-Type should not be redacted: %s", header.Get("Content-Type"))
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"io"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// WithRateLimit limits the client to requestsPerSecond requests on average, using a token bucket which allows
+// short bursts of up to one second worth of requests. Requests wait for their turn instead of failing.
+func WithRateLimit(requestsPerSecond float64) Option {
+	return func(o *options) {
+		o.requestsPerSecond = requestsPerSecond
+	}
+}
+
+// WithMaxConcurrentRequests limits the number of requests in flight at the same time. A request is in flight
+// until its response body is closed, which resty does after reading it.
+func WithMaxConcurrentRequests(maxConcurrentRequests int) Option {
+	return func(o *options) {
+		o.maxConcurrentRequests = maxConcurrentRequests
+	}
+}
+
+// throttledTransport applies the client rate limit and concurrency cap to every attempt of a request, so retries
+// are throttled as well.
+type throttledTransport struct {
+	next      http.RoundTripper
+	limiter   *rate.Limiter
+	semaphore chan struct{}
+}
+
+func newThrottledTransport(next http.RoundTripper, requestsPerSecond float64, maxConcurrentRequests int) *throttledTransport {
+	t := &throttledTransport{next: next}
+
+	if requestsPerSecond > 0 {
+		burst := int(math.Max(1, math.Ceil(requestsPerSecond)))
+		t.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+
+	if maxConcurrentRequests > 0 {
+		t.semaphore = make(chan struct{}, maxConcurrentRequests)
+	}
+
+	return t
+}
+
+func (t *throttledTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+
+	if t.semaphore != nil {
+		select {
+		case t.semaphore <- struct{}{}:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	release := sync.OnceFunc(func() {
+		if t.semaphore != nil {
+			<-t.semaphore
+		}
+	})
+
+	if t.limiter != nil {
+		if err := t.limiter.Wait(req.Context()); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	if wait := time.Since(start); wait > time.Millisecond {
+		newLogger(req.Context()).Debug("Waited for client rate limit", map[string]interface{}{
+			"http_method":  req.Method,
+			"http_url":     req.URL.String(),
+			"http_wait_ms": wait.Milliseconds(),
+		})
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		release()
+		return resp, err
+	}
+
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+
+	return resp, nil
+}
+
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (r *releaseOnClose) Close() error {
+	defer r.release()
+	return r.ReadCloser.Close()
+}
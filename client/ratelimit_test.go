@@ -0,0 +1,104 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithMaxConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c, err := Build(server.URL, "test", WithMaxConcurrentRequests(2))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.R().Get("/test"); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight != 2 {
+		t.Errorf("Incorrect max requests in flight. Expected 2: got: %d", maxInFlight)
+	}
+}
+
+func TestWithRateLimit(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c, err := Build(server.URL, "test", WithRateLimit(20))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// first 20 requests use the initial burst, the next 10 must wait for the bucket to refill
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		if _, err := c.R().Get("/test"); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("Requests were not rate limited. Expected at least 400ms: got: %s", elapsed)
+	}
+}
+
+func TestBuild_invalidThrottling(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Build("https://myinstance.jfrog.io", "test", WithRateLimit(-1)); err == nil {
+		t.Error("expected error for negative rate limit, got no error")
+	}
+
+	if _, err := Build("https://myinstance.jfrog.io", "test", WithMaxConcurrentRequests(-1)); err == nil {
+		t.Error("expected error for negative max concurrent requests, got no error")
+	}
+}
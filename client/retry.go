@@ -0,0 +1,177 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RetryPolicy controls how the client retries failed requests. Wait times between attempts grow exponentially
+// from MinWait up to MaxWait, with random jitter applied to each wait.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts for a request, including the first one. 1 disables retries.
+	MaxAttempts int
+	// MinWait is the wait time before the first retry.
+	MinWait time.Duration
+	// MaxWait caps the wait time between retries, including waits requested by a Retry-After header.
+	MaxWait time.Duration
+	// RetryableStatusCodes are the HTTP response status codes which trigger a retry.
+	RetryableStatusCodes []int
+	// RespectRetryAfter uses the Retry-After response header as the wait time when the server sends one.
+	RespectRetryAfter bool
+	// RetryNonIdempotent allows POST and PATCH requests to be retried as well.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns the retry policy used by Build when no policy is set.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 10,
+		MinWait:     500 * time.Millisecond,
+		MaxWait:     30 * time.Second,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RespectRetryAfter: true,
+	}
+}
+
+// Validate checks that the policy values are consistent
+func (p RetryPolicy) Validate() error {
+	if p.MaxAttempts < 1 {
+		return fmt.Errorf("retry max attempts must be at least 1, got %d", p.MaxAttempts)
+	}
+
+	if p.MinWait < 0 || p.MaxWait < 0 {
+		return fmt.Errorf("retry wait times must not be negative")
+	}
+
+	if p.MaxWait < p.MinWait {
+		return fmt.Errorf("retry max wait (%s) must not be less than min wait (%s)", p.MaxWait, p.MinWait)
+	}
+
+	for _, code := range p.RetryableStatusCodes {
+		if code < 100 || code > 599 {
+			return fmt.Errorf("invalid retryable status code %d", code)
+		}
+	}
+
+	return nil
+}
+
+// WithRetryPolicy replaces the default retry policy of the client.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retryPolicy = policy
+	}
+}
+
+func (p RetryPolicy) apply(c *resty.Client) {
+	c.SetRetryCount(p.MaxAttempts - 1).
+		SetRetryWaitTime(p.MinWait).
+		SetRetryMaxWaitTime(p.MaxWait).
+		AddRetryCondition(p.shouldRetry).
+		AddRetryHook(func(resp *resty.Response, err error) {
+			// the hook also runs after the last attempt, when no retry follows
+			if resp == nil || resp.Request == nil || resp.Request.Attempt >= p.MaxAttempts {
+				return
+			}
+
+			reason := resp.Status()
+			if err != nil {
+				reason = err.Error()
+			}
+			newLogger(resp.Request.Context()).Debug("Retrying HTTP request", map[string]interface{}{
+				"http_method":       resp.Request.Method,
+				"http_url":          resp.Request.URL,
+				"http_attempt":      resp.Request.Attempt,
+				"http_request_id":   RequestID(resp.Request),
+				"http_retry_reason": reason,
+			})
+		})
+
+	if p.RespectRetryAfter {
+		c.SetRetryAfter(retryAfter)
+	}
+}
+
+var idempotentMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodOptions,
+	http.MethodTrace,
+	http.MethodPut,
+	http.MethodDelete,
+}
+
+func (p RetryPolicy) shouldRetry(resp *resty.Response, err error) bool {
+	if resp == nil || resp.Request == nil || retryDisabled(resp.Request) {
+		return false
+	}
+
+	if !p.RetryNonIdempotent && !slices.Contains(idempotentMethods, resp.Request.Method) {
+		return false
+	}
+
+	// Connection level errors, e.g. connection reset or refused
+	if err != nil {
+		return true
+	}
+
+	return slices.Contains(p.RetryableStatusCodes, resp.StatusCode())
+}
+
+// retryAfter returns the wait time requested by the Retry-After header, either in seconds or as an HTTP date.
+// Returning 0 makes resty fall back to the exponential backoff with jitter.
+func retryAfter(_ *resty.Client, resp *resty.Response) (time.Duration, error) {
+	header := resp.Header().Get("Retry-After")
+	if header == "" {
+		return 0, nil
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second, nil
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, nil
+		}
+	}
+
+	return 0, nil
+}
+
+type noRetryKey struct{}
+
+// retryDisabled reports whether NeverRetry was added as a retry condition for the request
+func retryDisabled(r *resty.Request) bool {
+	disabled, _ := r.Context().Value(noRetryKey{}).(bool)
+	return disabled
+}
+
+func disableRetry(r *resty.Request) {
+	r.SetContext(context.WithValue(r.Context(), noRetryKey{}, true))
+}
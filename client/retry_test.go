@@ -0,0 +1,211 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func testRetryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.MaxAttempts = 3
+	policy.MinWait = time.Millisecond
+	policy.MaxWait = 5 * time.Millisecond
+	return policy
+}
+
+func flakyServer(t *testing.T, failures int32, status int) (*httptest.Server, *int32) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &attempts
+}
+
+func TestRetryPolicy_retryableStatus(t *testing.T) {
+	t.Parallel()
+
+	server, attempts := flakyServer(t, 2, http.StatusServiceUnavailable)
+
+	c, err := Build(server.URL, "test", WithRetryPolicy(testRetryPolicy()))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := c.R().Get("/artifactory/api/system/ping")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		t.Errorf("Incorrect status. Expected %d: got: %d", http.StatusOK, resp.StatusCode())
+	}
+
+	if *attempts != 3 {
+		t.Errorf("Incorrect attempts. Expected 3: got: %d", *attempts)
+	}
+}
+
+func TestRetryPolicy_noRetry(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		status  int
+		request func(c *resty.Client) (*resty.Response, error)
+	}{
+		"non retryable status": {
+			status: http.StatusInternalServerError,
+			request: func(c *resty.Client) (*resty.Response, error) {
+				return c.R().Get("/test")
+			},
+		},
+		"non idempotent method": {
+			status: http.StatusServiceUnavailable,
+			request: func(c *resty.Client) (*resty.Response, error) {
+				return c.R().Post("/test")
+			},
+		},
+		"never retry": {
+			status: http.StatusServiceUnavailable,
+			request: func(c *resty.Client) (*resty.Response, error) {
+				return c.R().AddRetryCondition(NeverRetry).Get("/test")
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server, attempts := flakyServer(t, 1, testCase.status)
+
+			c, err := Build(server.URL, "test", WithRetryPolicy(testRetryPolicy()))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			resp, err := testCase.request(c)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if resp.StatusCode() != testCase.status {
+				t.Errorf("Incorrect status. Expected %d: got: %d", testCase.status, resp.StatusCode())
+			}
+
+			if *attempts != 1 {
+				t.Errorf("Incorrect attempts. Expected 1: got: %d", *attempts)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_retryNonIdempotent(t *testing.T) {
+	t.Parallel()
+
+	server, attempts := flakyServer(t, 1, http.StatusTooManyRequests)
+
+	policy := testRetryPolicy()
+	policy.RetryNonIdempotent = true
+
+	c, err := Build(server.URL, "test", WithRetryPolicy(policy))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := c.R().Post("/test"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if *attempts != 2 {
+		t.Errorf("Incorrect attempts. Expected 2: got: %d", *attempts)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		header   string
+		expected time.Duration
+	}{
+		"absent":      {header: "", expected: 0},
+		"seconds":     {header: "3", expected: 3 * time.Second},
+		"past date":   {header: "Wed, 21 Oct 2015 07:28:00 GMT", expected: 0},
+		"unparseable": {header: "soon", expected: 0},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &resty.Response{
+				RawResponse: &http.Response{Header: http.Header{}},
+			}
+			if testCase.header != "" {
+				resp.RawResponse.Header.Set("Retry-After", testCase.header)
+			}
+
+			wait, err := retryAfter(nil, resp)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if wait != testCase.expected {
+				t.Errorf("Incorrect wait. Expected %s: got: %s", testCase.expected, wait)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_Validate(t *testing.T) {
+	t.Parallel()
+
+	invalidPolicies := map[string]RetryPolicy{
+		"no attempts":         {MaxAttempts: 0},
+		"negative wait":       {MaxAttempts: 1, MinWait: -time.Second},
+		"max less than min":   {MaxAttempts: 1, MinWait: time.Minute, MaxWait: time.Second},
+		"invalid status code": {MaxAttempts: 1, RetryableStatusCodes: []int{42}},
+	}
+
+	for name, policy := range invalidPolicies {
+		policy := policy
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := policy.Validate(); err == nil {
+				t.Error("expected error, got no error")
+			}
+		})
+	}
+
+	if err := DefaultRetryPolicy().Validate(); err != nil {
+		t.Errorf("unexpected error for default policy: %s", err)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// TLSConfig holds the TLS settings of the client. PEM values can either be inline PEM content or a path to a
+// PEM file.
+type TLSConfig struct {
+	// CACert is a PEM bundle of CA certificates trusted in addition to the system ones.
+	CACert string
+	// ClientCert and ClientKey are the PEM certificate and private key presented to the server for mutual TLS.
+	ClientCert string
+	ClientKey  string
+	// MinVersion is the minimum TLS version, "1.2" or "1.3". Empty means TLS 1.2.
+	MinVersion string
+	// InsecureSkipVerify disables server certificate verification. Only meant for testing.
+	InsecureSkipVerify bool
+}
+
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// WithTLSConfig sets the TLS settings of the client transport.
+func WithTLSConfig(config TLSConfig) Option {
+	return func(o *options) {
+		o.tlsConfig = &config
+	}
+}
+
+func (c TLSConfig) build() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+
+	if c.MinVersion != "" {
+		version, ok := tlsVersions[c.MinVersion]
+		if !ok {
+			return nil, fmt.Errorf("unsupported TLS min version '%s', must be one of 1.2 or 1.3", c.MinVersion)
+		}
+		tlsConfig.MinVersion = version
+	}
+
+	if c.CACert != "" {
+		caCert, err := loadPEM(c.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load CA certificate: %w", err)
+		}
+
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		if !rootCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("failed to load CA certificate: no PEM encoded certificate found")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if (c.ClientCert == "") != (c.ClientKey == "") {
+		return nil, fmt.Errorf("client certificate and client key must be set together")
+	}
+
+	if c.ClientCert != "" {
+		clientCert, err := loadPEM(c.ClientCert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+
+		clientKey, err := loadPEM(c.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client key: %w", err)
+		}
+
+		certificate, err := tls.X509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+// loadPEM returns value as is when it holds PEM content, otherwise reads the file at path value
+func loadPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+
+	return os.ReadFile(value)
+}
@@ -0,0 +1,174 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTLSServer(t *testing.T, clientAuth tls.ClientAuthType) (*httptest.Server, string) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: clientAuth}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	return server, string(caCert)
+}
+
+func newClientCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %s", err)
+	}
+
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}))
+}
+
+func TestWithTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	server, caCert := newTLSServer(t, tls.NoClientCert)
+
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caCertFile, []byte(caCert), 0600); err != nil {
+		t.Fatalf("failed to write CA file: %s", err)
+	}
+
+	testCases := map[string]struct {
+		opts        []Option
+		expectError bool
+	}{
+		"untrusted CA": {
+			expectError: true,
+		},
+		"inline CA": {
+			opts: []Option{WithTLSConfig(TLSConfig{CACert: caCert})},
+		},
+		"CA file": {
+			opts: []Option{WithTLSConfig(TLSConfig{CACert: caCertFile})},
+		},
+		"insecure skip verify": {
+			opts: []Option{WithTLSConfig(TLSConfig{InsecureSkipVerify: true})},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := Build(server.URL, "test", append(testCase.opts, WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))...)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			_, err = c.R().Get("/test")
+			if err == nil && testCase.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if err != nil && !testCase.expectError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestWithTLSConfig_clientCertificate(t *testing.T) {
+	t.Parallel()
+
+	server, caCert := newTLSServer(t, tls.RequireAnyClientCert)
+	clientCert, clientKey := newClientCertificate(t)
+
+	c, err := Build(server.URL, "test", WithTLSConfig(TLSConfig{CACert: caCert}), WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := c.R().Get("/test"); err == nil {
+		t.Fatal("expected error without client certificate, got no error")
+	}
+
+	c, err = Build(server.URL, "test", WithTLSConfig(TLSConfig{CACert: caCert, ClientCert: clientCert, ClientKey: clientKey, MinVersion: "1.3"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := c.R().Get("/test"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestWithTLSConfig_invalid(t *testing.T) {
+	t.Parallel()
+
+	clientCert, clientKey := newClientCertificate(t)
+
+	invalidConfigs := map[string]TLSConfig{
+		"missing CA file":     {CACert: filepath.Join(t.TempDir(), "missing.pem")},
+		"CA without PEM":      {CACert: "-----BEGIN CERTIFICATE-----\ninvalid\n-----END CERTIFICATE-----"},
+		"cert without key":    {ClientCert: clientCert},
+		"key without cert":    {ClientKey: clientKey},
+		"mismatched pair":     {ClientCert: clientKey, ClientKey: clientCert},
+		"unsupported version": {MinVersion: "1.0"},
+	}
+
+	for name, config := range invalidConfigs {
+		config := config
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := Build("https://myinstance.jfrog.io", "test", WithTLSConfig(config)); err == nil {
+				t.Error("expected error, got no error")
+			}
+		})
+	}
+}
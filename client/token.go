@@ -0,0 +1,254 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// tokenExpiryDelta is how long before its expiry a token is refreshed
+const tokenExpiryDelta = time.Minute
+
+// Token is an access token with its optional refresh token and expiry time
+type Token struct {
+	AccessToken  string
+	RefreshToken string
+	// Expiry is when AccessToken expires. When zero, it is decoded from the token if it is a JWT.
+	Expiry time.Time
+}
+
+// TokenSource provides access tokens. Token is called for the first token, ahead of its expiry and after the
+// platform rejected it with a 401 response. Calls are serialized.
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+// StaticTokenSource always returns the same access token
+type StaticTokenSource string
+
+func (s StaticTokenSource) Token(_ context.Context) (*Token, error) {
+	return &Token{AccessToken: string(s)}, nil
+}
+
+type skipAuthKey struct{}
+
+// WithoutAuth returns a context for requests which must not use the client token source, e.g. the requests
+// a TokenSource sends to obtain a token.
+func WithoutAuth(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipAuthKey{}, true)
+}
+
+// AddTokenSource authenticates the client requests with tokens from source. The first token is requested right
+// away, so configuration errors are reported early. Tokens are refreshed ahead of their expiry, and once after a
+// 401 response, with concurrent requests sharing a single refresh.
+func AddTokenSource(ctx context.Context, c *resty.Client, source TokenSource) error {
+	transport := &tokenTransport{
+		next:   c.GetClient().Transport,
+		source: source,
+	}
+
+	if _, err := transport.token(ctx, nil); err != nil {
+		return err
+	}
+
+	c.SetTransport(transport)
+
+	return nil
+}
+
+// CurrentToken returns the token the client currently authenticates with, without calling its token source, or nil
+// when no token source was added to the client with AddTokenSource
+func CurrentToken(c *resty.Client) *Token {
+	transport, ok := c.GetClient().Transport.(*tokenTransport)
+	if !ok {
+		return nil
+	}
+
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+
+	return transport.current
+}
+
+type tokenTransport struct {
+	next   http.RoundTripper
+	source TokenSource
+
+	mu      sync.Mutex
+	current *Token
+	// refresh is the refresh in progress, if any, which concurrent requests wait for
+	refresh *tokenRefresh
+}
+
+type tokenRefresh struct {
+	done  chan struct{}
+	token *Token
+	err   error
+}
+
+// token returns the current token, refreshing it when it is about to expire or when it is rejected, which is
+// when it is the same as the rejected token. The lock is not held while the token source is called, as the
+// source may send requests through the same client.
+func (t *tokenTransport) token(ctx context.Context, rejected *Token) (*Token, error) {
+	t.mu.Lock()
+	current := t.current
+	if current != nil && current != rejected && !expiresSoon(current) {
+		t.mu.Unlock()
+		return current, nil
+	}
+
+	refresh := t.refresh
+	if refresh == nil {
+		refresh = &tokenRefresh{done: make(chan struct{})}
+		t.refresh = refresh
+		t.mu.Unlock()
+
+		t.refreshToken(ctx, refresh, current, rejected)
+	} else {
+		t.mu.Unlock()
+	}
+
+	select {
+	case <-refresh.done:
+		return refresh.token, refresh.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (t *tokenTransport) refreshToken(ctx context.Context, refresh *tokenRefresh, current, rejected *Token) {
+	token, err := t.source.Token(ctx)
+
+	t.mu.Lock()
+	defer func() {
+		t.refresh = nil
+		t.mu.Unlock()
+		close(refresh.done)
+	}()
+
+	if err != nil {
+		// keep using a token which has not expired yet, the next request tries again
+		if current != nil && current != rejected && time.Now().Before(current.Expiry) {
+			newLogger(ctx).Warn("Failed to refresh access token, using current token until it expires", map[string]interface{}{
+				"token_expiry": current.Expiry,
+				"error":        err.Error(),
+			})
+			refresh.token = current
+			return
+		}
+		refresh.err = fmt.Errorf("failed to get access token: %w", err)
+		return
+	}
+
+	if token.Expiry.IsZero() {
+		token.Expiry = JWTExpiry(token.AccessToken)
+	}
+
+	if current != nil {
+		newLogger(ctx).Debug("Refreshed access token", map[string]interface{}{"token_expiry": token.Expiry})
+	}
+	t.current = token
+	refresh.token = token
+}
+
+func expiresSoon(token *Token) bool {
+	return !token.Expiry.IsZero() && time.Until(token.Expiry) < tokenExpiryDelta
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if skip, _ := req.Context().Value(skipAuthKey{}).(bool); skip || req.Header.Get("Authorization") != "" {
+		return t.next.RoundTrip(req)
+	}
+
+	token, err := t.token(req.Context(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(withToken(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || (req.Body != nil && req.GetBody == nil) {
+		return resp, err
+	}
+
+	// the response holds a slot of the client concurrency cap until its body is closed, and the token source
+	// may need one to refresh the token
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	refreshed, err := t.token(req.Context(), token)
+	if err != nil || refreshed.AccessToken == token.AccessToken {
+		return resp, nil
+	}
+
+	retry := withToken(req, refreshed)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+
+	newLogger(req.Context()).Debug("Retrying HTTP request with refreshed access token after 401 response", map[string]interface{}{
+		"http_method": req.Method,
+		"http_url":    req.URL.String(),
+	})
+
+	return t.next.RoundTrip(retry)
+}
+
+func withToken(req *http.Request, token *Token) *http.Request {
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return r
+}
+
+// JWTExpiry returns the expiry time ("exp" claim) of accessToken, or zero time when accessToken is not a JWT
+// or has no expiry. The token signature is not verified.
+func JWTExpiry(accessToken string) time.Time {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(claims.Exp, 0)
+}
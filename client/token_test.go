@@ -0,0 +1,302 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type testTokenSource struct {
+	calls  int32
+	expiry time.Duration
+}
+
+func (s *testTokenSource) Token(_ context.Context) (*Token, error) {
+	calls := atomic.AddInt32(&s.calls, 1)
+
+	token := &Token{AccessToken: fmt.Sprintf("token-%d", calls)}
+	if s.expiry != 0 {
+		token.Expiry = time.Now().Add(s.expiry)
+	}
+	return token, nil
+}
+
+// tokenServer only accepts validToken, and echoes the request body
+func tokenServer(t *testing.T, validToken string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+validToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+		w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestAddTokenSource_refreshOnUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	server := tokenServer(t, "token-2")
+
+	c, err := Build(server.URL, "test", WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	source := &testTokenSource{}
+	if err := AddTokenSource(context.Background(), c, source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := c.R().SetBody(`{"key":"value"}`).Post("/test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("Incorrect status. Expected %d: got: %d", http.StatusOK, resp.StatusCode())
+	}
+
+	if resp.String() != `{"key":"value"}` {
+		t.Errorf("Request body was not sent again. Got: %s", resp.String())
+	}
+
+	if source.calls != 2 {
+		t.Errorf("Incorrect token source calls. Expected 2: got: %d", source.calls)
+	}
+}
+
+func TestAddTokenSource_refreshOnce(t *testing.T) {
+	t.Parallel()
+
+	server := tokenServer(t, "token-2")
+
+	c, err := Build(server.URL, "test", WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	source := &testTokenSource{}
+	if err := AddTokenSource(context.Background(), c, source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := c.R().Get("/test")
+			if err != nil || resp.StatusCode() != http.StatusOK {
+				t.Errorf("unexpected response: %v %v", resp, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if source.calls != 2 {
+		t.Errorf("Incorrect token source calls. Expected 2: got: %d", source.calls)
+	}
+}
+
+// clientTokenSource gets its tokens with requests through the client it authenticates, like OIDCTokenSource
+type clientTokenSource struct {
+	client *resty.Client
+}
+
+func (s *clientTokenSource) Token(ctx context.Context) (*Token, error) {
+	resp, err := s.client.R().SetContext(WithoutAuth(ctx)).Post("/token")
+	if err := CheckResponse(resp, err); err != nil {
+		return nil, err
+	}
+	return &Token{AccessToken: resp.String()}, nil
+}
+
+func TestAddTokenSource_refreshWithMaxConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	var tokens int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			fmt.Fprintf(w, "token-%d", atomic.AddInt32(&tokens, 1))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"errors":[{"status":401,"message":"Bad credentials"}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := Build(server.URL, "test", WithRetryPolicy(RetryPolicy{MaxAttempts: 1}), WithMaxConcurrentRequests(1))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := AddTokenSource(context.Background(), c, &clientTokenSource{client: c}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := c.R().SetContext(ctx).Get("/test")
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			if resp.StatusCode() != http.StatusOK {
+				t.Errorf("Incorrect status. Expected %d: got: %d", http.StatusOK, resp.StatusCode())
+			}
+		}()
+	}
+	wg.Wait()
+
+	if tokens != 2 {
+		t.Errorf("Incorrect token requests. Expected 2: got: %d", tokens)
+	}
+}
+
+func TestAddTokenSource_refreshBeforeExpiry(t *testing.T) {
+	t.Parallel()
+
+	server := tokenServer(t, "token-2")
+
+	c, err := Build(server.URL, "test", WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// tokens expire within tokenExpiryDelta so each request gets a new one
+	source := &testTokenSource{expiry: 30 * time.Second}
+	if err := AddTokenSource(context.Background(), c, source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := c.R().Get("/test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		t.Errorf("Incorrect status. Expected %d: got: %d", http.StatusOK, resp.StatusCode())
+	}
+}
+
+func TestAddTokenSource_static(t *testing.T) {
+	t.Parallel()
+
+	server := tokenServer(t, "valid")
+
+	c, err := Build(server.URL, "test", WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := AddTokenSource(context.Background(), c, StaticTokenSource("invalid")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := c.R().Get("/test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if resp.StatusCode() != http.StatusUnauthorized {
+		t.Errorf("Incorrect status. Expected %d: got: %d", http.StatusUnauthorized, resp.StatusCode())
+	}
+}
+
+func TestCurrentToken(t *testing.T) {
+	t.Parallel()
+
+	server := tokenServer(t, "token-1")
+
+	c, err := Build(server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if token := CurrentToken(c); token != nil {
+		t.Errorf("Incorrect token without token source. Expected nil: got: %v", token)
+	}
+
+	source := &testTokenSource{}
+	if err := AddTokenSource(context.Background(), c, source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		token := CurrentToken(c)
+		if token == nil || token.AccessToken != "token-1" {
+			t.Errorf("Incorrect token. Expected token-1: got: %v", token)
+		}
+	}
+
+	if source.calls != 1 {
+		t.Errorf("Incorrect token source calls. Expected 1: got: %d", source.calls)
+	}
+}
+
+func TestJWTExpiry(t *testing.T) {
+	t.Parallel()
+
+	jwt := func(payload string) string {
+		return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+	}
+
+	testCases := map[string]struct {
+		token    string
+		expected time.Time
+	}{
+		"jwt":           {token: jwt(`{"sub":"user","exp":1700000000}`), expected: time.Unix(1700000000, 0)},
+		"jwt no expiry": {token: jwt(`{"sub":"user"}`)},
+		"reference":     {token: "cmVmdGtuOjAxOjE3MDAwMDAwMDA6abcdef"},
+		"invalid jwt":   {token: "a.b.c"},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if expiry := JWTExpiry(testCase.token); !expiry.Equal(testCase.expected) {
+				t.Errorf("Incorrect expiry. Expected %s: got: %s", testCase.expected, expiry)
+			}
+		})
+	}
+}
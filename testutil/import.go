@@ -0,0 +1,75 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// ImportStateIdFunc builds the import ID of the resource from its state attributes, for ImportStateIdFunc
+func ImportStateIdFunc(resourceName string, format util.ImportIDFormat) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		values := map[string]string{}
+		for _, segment := range format.Segments() {
+			values[segment] = rs.Primary.Attributes[segment]
+		}
+
+		return format.Build(values)
+	}
+}
+
+// CheckImportStateIDFormat is used in ImportStateCheck. It checks the imported state attributes against the
+// segments of the import ID, and that they build the same import ID again. It generalizes
+// validator.CheckImportState to composite import IDs.
+func CheckImportStateIDFormat(importID string, format util.ImportIDFormat) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		if len(states) == 0 {
+			return fmt.Errorf("no import state")
+		}
+		instanceState := states[0]
+
+		values, err := format.Parse(importID)
+		if err != nil {
+			return err
+		}
+
+		imported := map[string]string{}
+		for _, segment := range format.Segments() {
+			imported[segment] = instanceState.Attributes[segment]
+			if value, ok := values[segment]; ok && imported[segment] != value {
+				return fmt.Errorf("incorrect state attribute '%s': %s", segment, imported[segment])
+			}
+		}
+
+		roundTrip, err := format.Build(imported)
+		if err != nil {
+			return err
+		}
+		if roundTrip != importID {
+			return fmt.Errorf("incorrect import ID built from state: %s", roundTrip)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-shared/client"
+)
+
+const DefaultCatalogHealthTTL = 5 * time.Minute
+
+// CatalogHealth is the report of the Catalog app health endpoint. Problems lists every failing condition.
+type CatalogHealth struct {
+	Code                     string
+	EntitledForCatalog       bool
+	HasCentralToken          bool
+	TokenExpired             bool
+	DbConnectionWorking      bool
+	CentralConnectionWorking bool
+	OneModelAvailable        bool
+	Problems                 []string
+	CheckedAt                time.Time
+}
+
+func (h CatalogHealth) Healthy() bool {
+	return len(h.Problems) == 0
+}
+
+// Err returns an error listing all problems, or nil if Catalog is healthy
+func (h CatalogHealth) Err() error {
+	if h.Healthy() {
+		return nil
+	}
+	return fmt.Errorf("catalog is not healthy: %s", strings.Join(h.Problems, "; "))
+}
+
+// GetCatalogHealth returns the Catalog health report. An error is only returned if the report could not be fetched.
+func GetCatalogHealth(ctx context.Context, restyClient *resty.Client) (*CatalogHealth, error) {
+	type CatalogEntitlements struct {
+		EntitledForCatalog bool `json:"entitled_for_catalog"`
+		HasCentralToken    bool `json:"has_central_token"`
+		TokenExpired       bool `json:"token_expired"`
+	}
+
+	type CatalogCentral struct {
+		CentralConnectionWorking bool `json:"central_connection_working"`
+	}
+
+	type CatalogHealthResponse struct {
+		Entitlements        CatalogEntitlements `json:"entitlements"`
+		Central             CatalogCentral      `json:"central"`
+		DbConnectionWorking bool                `json:"db_connection_working"`
+		OneModelAvailable   bool                `json:"one_model_available"`
+		Code                string              `json:"code"`
+	}
+
+	catalogHealth := CatalogHealthResponse{}
+	resp, err := restyClient.R().
+		SetContext(ctx).
+		SetResult(&catalogHealth).
+		Get("/catalog/api/v1/system/app_health")
+
+	if err := client.CheckResponse(resp, err); err != nil {
+		return nil, fmt.Errorf("failed to validate catalog health. %w", err)
+	}
+
+	health := &CatalogHealth{
+		Code:                     catalogHealth.Code,
+		EntitledForCatalog:       catalogHealth.Entitlements.EntitledForCatalog,
+		HasCentralToken:          catalogHealth.Entitlements.HasCentralToken,
+		TokenExpired:             catalogHealth.Entitlements.TokenExpired,
+		DbConnectionWorking:      catalogHealth.DbConnectionWorking,
+		CentralConnectionWorking: catalogHealth.Central.CentralConnectionWorking,
+		OneModelAvailable:        catalogHealth.OneModelAvailable,
+		CheckedAt:                time.Now(),
+	}
+
+	if health.Code != "OK" {
+		health.Problems = append(health.Problems, fmt.Sprintf("catalog health check failed with code: %s", health.Code))
+	}
+	if !health.EntitledForCatalog {
+		health.Problems = append(health.Problems, "catalog is not entitled for use")
+	}
+	if health.TokenExpired {
+		health.Problems = append(health.Problems, "catalog token has expired")
+	}
+	if !health.DbConnectionWorking {
+		health.Problems = append(health.Problems, "catalog database connection is not working")
+	}
+	if !health.CentralConnectionWorking {
+		health.Problems = append(health.Problems, "catalog central connection is not working")
+	}
+	if !health.OneModelAvailable {
+		health.Problems = append(health.Problems, "catalog model is not available")
+	}
+
+	return health, nil
+}
+
+// CatalogHealthChecker caches the Catalog health of one platform for a TTL. Transient errors, i.e. connection
+// errors, 429 and 5xx responses, are never cached, so a later check tries again. Retries are left to the retry
+// policy of the client. Concurrent checks share a single request.
+type CatalogHealthChecker struct {
+	TTL time.Duration
+
+	mu     sync.Mutex
+	health *CatalogHealth
+	err    error
+	expiry time.Time
+	// check is the check in progress, if any, which concurrent checks wait for
+	check *catalogHealthCheck
+}
+
+type catalogHealthCheck struct {
+	done   chan struct{}
+	health *CatalogHealth
+	err    error
+}
+
+func (c *CatalogHealthChecker) Check(ctx context.Context, restyClient *resty.Client) (*CatalogHealth, error) {
+	c.mu.Lock()
+	if time.Now().Before(c.expiry) {
+		defer c.mu.Unlock()
+		return c.health, c.err
+	}
+
+	check := c.check
+	if check == nil {
+		check = &catalogHealthCheck{done: make(chan struct{})}
+		c.check = check
+		c.mu.Unlock()
+
+		// the lock is not held during the request, so waiting checks can give up when their context is done
+		check.health, check.err = GetCatalogHealth(ctx, restyClient)
+
+		c.mu.Lock()
+		if check.err == nil || !isTransient(check.err) {
+			ttl := c.TTL
+			if ttl <= 0 {
+				ttl = DefaultCatalogHealthTTL
+			}
+
+			c.health, c.err = check.health, check.err
+			c.expiry = time.Now().Add(ttl)
+		}
+		c.check = nil
+		close(check.done)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-check.done:
+		return check.health, check.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func isTransient(err error) bool {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		return true
+	}
+	return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= http.StatusInternalServerError
+}
+
+// CheckCatalogHealth returns the cached Catalog health of the provider's platform. Provider metadata created
+// without a CatalogHealthChecker, i.e. not by JFrogProvider.Configure, checks the health every time.
+func (m ProviderMetadata) CheckCatalogHealth(ctx context.Context) (*CatalogHealth, error) {
+	if m.CatalogHealthChecker == nil {
+		return GetCatalogHealth(ctx, m.Client)
+	}
+	return m.CatalogHealthChecker.Check(ctx, m.Client)
+}
@@ -0,0 +1,197 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jfrog/terraform-provider-shared/client"
+)
+
+func TestGetCatalogHealth_reportsAllProblems(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"code":"OK","entitlements":{"entitled_for_catalog":true,"token_expired":true},"central":{"central_connection_working":false},"db_connection_working":true,"one_model_available":true}`))
+	}))
+	defer server.Close()
+
+	restyClient, err := client.Build(server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	health, err := GetCatalogHealth(context.Background(), restyClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{"catalog token has expired", "catalog central connection is not working"}
+	if strings.Join(health.Problems, ",") != strings.Join(expected, ",") {
+		t.Errorf("Incorrect problems. Expected %v: got: %v", expected, health.Problems)
+	}
+
+	if health.Healthy() || health.Err() == nil {
+		t.Error("expected unhealthy catalog")
+	}
+}
+
+func TestCatalogHealthChecker(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// first request fails transiently, and is retried by the client
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"code":"OK","entitlements":{"entitled_for_catalog":true},"central":{"central_connection_working":true},"db_connection_working":true,"one_model_available":true}`))
+	}))
+	defer server.Close()
+
+	policy := client.DefaultRetryPolicy()
+	policy.MinWait = time.Millisecond
+	policy.MaxWait = time.Millisecond
+	restyClient, err := client.Build(server.URL, "test", client.WithRetryPolicy(policy))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	checker := &CatalogHealthChecker{}
+	for i := 0; i < 3; i++ {
+		health, err := checker.Check(context.Background(), restyClient)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !health.Healthy() {
+			t.Errorf("expected healthy catalog, got problems: %v", health.Problems)
+		}
+	}
+
+	if requests.Load() != 2 {
+		t.Errorf("Incorrect request count. Expected 2: got: %d", requests.Load())
+	}
+}
+
+func TestCatalogHealthChecker_transientErrorNotCached(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	restyClient, err := client.Build(server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	checker := &CatalogHealthChecker{}
+	for i := 0; i < 2; i++ {
+		if _, err := checker.Check(context.Background(), restyClient); err == nil {
+			t.Fatal("expected error, got no error")
+		}
+	}
+
+	// 500 isn't retried by the default client policy, and the error isn't cached
+	if requests.Load() != 2 {
+		t.Errorf("Incorrect request count. Expected 2: got: %d", requests.Load())
+	}
+}
+
+func TestCatalogHealthChecker_concurrent(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"code":"OK","entitlements":{"entitled_for_catalog":true},"central":{"central_connection_working":true},"db_connection_working":true,"one_model_available":true}`))
+	}))
+	defer server.Close()
+
+	restyClient, err := client.Build(server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	checker := &CatalogHealthChecker{}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := checker.Check(context.Background(), restyClient); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+
+	for requests.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// a check waiting for the one in progress gives up with its context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := checker.Check(ctx, restyClient); err != context.Canceled {
+		t.Errorf("Incorrect error. Expected %v: got: %v", context.Canceled, err)
+	}
+
+	close(release)
+	wg.Wait()
+
+	if requests.Load() != 1 {
+		t.Errorf("Incorrect request count. Expected 1: got: %d", requests.Load())
+	}
+}
+
+func TestCatalogHealthChecker_perProviderInstance(t *testing.T) {
+	newServer := func(code string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"code":"` + code + `","entitlements":{"entitled_for_catalog":true},"central":{"central_connection_working":true},"db_connection_working":true,"one_model_available":true}`))
+		}))
+	}
+
+	healthyServer := newServer("OK")
+	defer healthyServer.Close()
+	unhealthyServer := newServer("DEGRADED")
+	defer unhealthyServer.Close()
+
+	var providers []ProviderMetadata
+	for _, url := range []string{healthyServer.URL, unhealthyServer.URL} {
+		restyClient, err := client.Build(url, "test")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		providers = append(providers, ProviderMetadata{Client: restyClient, CatalogHealthChecker: &CatalogHealthChecker{}})
+	}
+
+	if err := (JFrogResource{}).ValidateCatalogHealth(&providers[0]); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if err := (JFrogResource{}).ValidateCatalogHealth(&providers[1]); err == nil {
+		t.Error("expected error for unhealthy catalog, got no error")
+	}
+}
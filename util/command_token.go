@@ -0,0 +1,64 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jfrog/terraform-provider-shared/client"
+)
+
+// CommandTokenSource is a client.TokenSource which runs a credential helper command, e.g. a Vault or 1Password
+// CLI, and reads the access token from its standard output. The output is either the access token or a JSON
+// object with `access_token` and optional `expires_in` (seconds) fields. The command runs again when the token
+// expires or is rejected. Its output is never logged.
+type CommandTokenSource struct {
+	// Command is the executable followed by its arguments. It is run directly, not through a shell.
+	Command []string
+	Timeout time.Duration
+}
+
+func (s CommandTokenSource) Token(ctx context.Context) (*client.Token, error) {
+	output, err := runCommand(ctx, s.Command, s.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("access token command failed: %w", err)
+	}
+
+	if !strings.HasPrefix(output, "{") {
+		return &client.Token{AccessToken: output}, nil
+	}
+
+	var result struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		// do not include output, it may hold the token
+		return nil, fmt.Errorf("access token command output is not a valid JSON object")
+	}
+
+	if result.AccessToken == "" {
+		return nil, fmt.Errorf("access token command output has no access_token field")
+	}
+
+	return &client.Token{
+		AccessToken: result.AccessToken,
+		Expiry:      expiry(result.ExpiresIn),
+	}, nil
+}
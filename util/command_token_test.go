@@ -0,0 +1,77 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestCommandTokenSource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		command        []string
+		expectedToken  string
+		expectedExpiry bool
+	}{
+		"plain token": {command: []string{"echo", "helper-token"}, expectedToken: "helper-token"},
+		"json token":  {command: []string{"echo", `{"access_token":"json-token","expires_in":3600}`}, expectedToken: "json-token", expectedExpiry: true},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			token, err := CommandTokenSource{Command: testCase.command}.Token(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if token.AccessToken != testCase.expectedToken {
+				t.Errorf("Incorrect token. Expected %s: got: %s", testCase.expectedToken, token.AccessToken)
+			}
+
+			if token.Expiry.IsZero() == testCase.expectedExpiry {
+				t.Errorf("Incorrect expiry. Expected set %v: got: %v", testCase.expectedExpiry, token.Expiry)
+			}
+		})
+	}
+}
+
+func TestCommandTokenSource_errors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]CommandTokenSource{
+		"no command":      {},
+		"failing command": {Command: []string{"false"}},
+		"invalid json":    {Command: []string{"echo", `{"access_token":`}},
+		"no access token": {Command: []string{"echo", `{"expires_in":3600}`}},
+		"timeout":         {Command: []string{"sleep", "5"}, Timeout: 10 * time.Millisecond},
+	}
+
+	for name, source := range testCases {
+		source := source
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := source.Token(context.Background()); err == nil {
+				t.Error("expected error, got no error")
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+type JFrogDataSource struct {
+	ProviderData            *ProviderMetadata
+	TypeName                string
+	ValidArtifactoryVersion string
+	// VersionConstraints holds a go-version constraint string per product, e.g. `>= 7.49, < 7.90 || >= 7.94`
+	VersionConstraints    map[Product]string
+	DocumentEndpoint      string
+	CollectionEndpoint    string
+	CatalogHealthRequired bool
+}
+
+func (d *JFrogDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *JFrogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	m := req.ProviderData.(ProviderMetadata)
+	d.ProviderData = &m
+}
+
+func (d JFrogDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(ValidateAttributeVersions(ctx, d.ProviderData, req.Config)...)
+
+	if d.ProviderData == nil {
+		return
+	}
+
+	constraints := map[Product]string{}
+	if d.ValidArtifactoryVersion != "" {
+		constraints[ProductArtifactory] = ">= " + d.ValidArtifactoryVersion
+	}
+	for product, constraint := range d.VersionConstraints {
+		constraints[product] = constraint
+	}
+
+	resp.Diagnostics.Append(ValidateVersionConstraints(d.ProviderData, constraints, "This data source")...)
+}
+
+// ValidateCatalogHealth performs catalog health check when provider data becomes available. The result is cached
+// per provider instance, see CatalogHealthChecker.
+func (d JFrogDataSource) ValidateCatalogHealth(providerData *ProviderMetadata) error {
+	return validateCatalogHealth(providerData)
+}
@@ -14,31 +14,10 @@
 
 package util
 
-import (
-	"fmt"
+import "github.com/jfrog/terraform-provider-shared/client"
 
-	"github.com/samber/lo"
-)
+// JFrogErrors is kept for backward compatibility, use client.JFrogErrors or client.APIError instead.
+type JFrogErrors = client.JFrogErrors
 
-type JFrogErrors struct {
-	Errors []JFrogError `json:"errors"`
-}
-
-func (e JFrogErrors) String() string {
-	return lo.Reduce(
-		e.Errors,
-		func(agg string, err JFrogError, _ int) string {
-			if agg == "" {
-				return err.Message
-			}
-
-			return fmt.Sprintf("%s %s.", agg, err.Message)
-		},
-		"",
-	)
-}
-
-type JFrogError struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-}
+// JFrogError is kept for backward compatibility, use client.JFrogError instead.
+type JFrogError = client.JFrogError
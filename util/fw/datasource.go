@@ -0,0 +1,275 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fw
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/jfrog/terraform-provider-shared/client"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// ReadDocument fetches a single object from the endpoint
+func ReadDocument[API any](ctx context.Context, restyClient *resty.Client, endpoint string, pathParams map[string]string) (API, error) {
+	var api API
+	response, err := restyClient.R().
+		SetContext(ctx).
+		SetPathParams(pathParams).
+		SetResult(&api).
+		Get(endpoint)
+	if err := client.CheckResponse(response, err); err != nil {
+		return api, err
+	}
+
+	return api, nil
+}
+
+const DefaultMaxPages = 1000
+
+type ListOptions struct {
+	PathParams  map[string]string
+	QueryParams map[string]string
+	// ItemsField is the JSON field holding the items, for responses which wrap them in an object
+	ItemsField string
+	// PageSize enables offset pagination with the OffsetParam and LimitParam query parameters. Pages are fetched
+	// until one has fewer items than PageSize, or is identical to the previous page, as returned by endpoints
+	// ignoring the pagination parameters.
+	PageSize int
+	// MaxPages caps the number of fetched pages, reading more is an error. Defaults to DefaultMaxPages.
+	MaxPages int
+	// OffsetParam defaults to `offset`
+	OffsetParam string
+	// LimitParam defaults to `limit`
+	LimitParam string
+}
+
+// ReadCollection fetches all items from the endpoint, following pagination if enabled
+func ReadCollection[API any](ctx context.Context, restyClient *resty.Client, endpoint string, opts ListOptions) ([]API, error) {
+	offsetParam := opts.OffsetParam
+	if offsetParam == "" {
+		offsetParam = "offset"
+	}
+	limitParam := opts.LimitParam
+	if limitParam == "" {
+		limitParam = "limit"
+	}
+	maxPages := opts.MaxPages
+	if maxPages <= 0 {
+		maxPages = DefaultMaxPages
+	}
+
+	var items []API
+	var previous []byte
+	for pages, offset := 0, 0; ; pages, offset = pages+1, offset+opts.PageSize {
+		req := restyClient.R().
+			SetContext(ctx).
+			SetPathParams(opts.PathParams).
+			SetQueryParams(opts.QueryParams)
+		if opts.PageSize > 0 {
+			req.SetQueryParam(offsetParam, strconv.Itoa(offset))
+			req.SetQueryParam(limitParam, strconv.Itoa(opts.PageSize))
+		}
+
+		response, err := req.Get(endpoint)
+		if err := client.CheckResponse(response, err); err != nil {
+			return nil, err
+		}
+
+		// the endpoint ignores the offset
+		if previous != nil && bytes.Equal(response.Body(), previous) {
+			break
+		}
+		previous = response.Body()
+
+		page, err := decodeItems[API](response.Body(), opts.ItemsField)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s response: %w", endpoint, err)
+		}
+
+		if len(page) == 0 {
+			break
+		}
+
+		if pages == maxPages {
+			return nil, fmt.Errorf("failed to read %s: more than %d pages of %d items", endpoint, maxPages, opts.PageSize)
+		}
+
+		items = append(items, page...)
+
+		// a page with fewer items than requested is the last one, and a page with more means the endpoint
+		// ignores pagination
+		if opts.PageSize <= 0 || len(page) != opts.PageSize {
+			break
+		}
+	}
+
+	return items, nil
+}
+
+func decodeItems[API any](body []byte, itemsField string) ([]API, error) {
+	var items []API
+
+	if len(body) == 0 {
+		return items, nil
+	}
+
+	if itemsField == "" {
+		err := json.Unmarshal(body, &items)
+		return items, err
+	}
+
+	var wrapper map[string]json.RawMessage
+	if err := json.Unmarshal(body, &wrapper); err != nil {
+		return nil, err
+	}
+
+	raw, ok := wrapper[itemsField]
+	if !ok || string(raw) == "null" {
+		return items, nil
+	}
+
+	err := json.Unmarshal(raw, &items)
+	return items, err
+}
+
+// JFrogDataSource reads a single object from DocumentEndpoint, with Model the Terraform model and API the JSON
+// payload. Endpoint path parameters, e.g. `{key}`, are filled from PathParams. Data sources embed it and add the
+// Schema method.
+type JFrogDataSource[Model any, API any] struct {
+	util.JFrogDataSource
+
+	// FromAPI updates the state from the API payload
+	FromAPI func(ctx context.Context, api API, model *Model) diag.Diagnostics
+	// PathParams returns the endpoint path parameters. Defaults to the model attributes with the same names as
+	// the parameters, e.g. `{key}` is the value of the `key` attribute.
+	PathParams func(ctx context.Context, model Model) (map[string]string, diag.Diagnostics)
+}
+
+func (d *JFrogDataSource[Model, API]) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	d.ProviderData.RecordUsage(ctx, util.DataSourceFeatureUsage(d.TypeName, "READ"))
+
+	var model Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := modelPathParams(model, d.DocumentEndpoint)
+	if d.PathParams != nil {
+		var diags diag.Diagnostics
+		params, diags = d.PathParams(ctx, model)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	api, err := ReadDocument[API](ctx, d.ProviderData.Client, d.DocumentEndpoint, params)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(d.FromAPI(ctx, api, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// JFrogListDataSource reads all items of CollectionEndpoint which pass Filter
+type JFrogListDataSource[Model any, API any] struct {
+	util.JFrogDataSource
+
+	// FromAPI updates the state from the filtered items
+	FromAPI func(ctx context.Context, items []API, model *Model) diag.Diagnostics
+	// PathParams returns the endpoint path parameters. Defaults to the model attributes with the same names as
+	// the parameters.
+	PathParams func(ctx context.Context, model Model) (map[string]string, diag.Diagnostics)
+	// QueryParams returns query parameters, e.g. for server side filtering
+	QueryParams func(ctx context.Context, model Model) (map[string]string, diag.Diagnostics)
+	// Filter selects the items to keep, for client side filtering
+	Filter func(ctx context.Context, model Model, item API) bool
+
+	ItemsField  string
+	PageSize    int
+	OffsetParam string
+	LimitParam  string
+}
+
+func (d *JFrogListDataSource[Model, API]) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	d.ProviderData.RecordUsage(ctx, util.DataSourceFeatureUsage(d.TypeName, "READ"))
+
+	var model Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := ListOptions{
+		PathParams:  modelPathParams(model, d.CollectionEndpoint),
+		ItemsField:  d.ItemsField,
+		PageSize:    d.PageSize,
+		OffsetParam: d.OffsetParam,
+		LimitParam:  d.LimitParam,
+	}
+
+	if d.PathParams != nil {
+		var diags diag.Diagnostics
+		opts.PathParams, diags = d.PathParams(ctx, model)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if d.QueryParams != nil {
+		var diags diag.Diagnostics
+		opts.QueryParams, diags = d.QueryParams(ctx, model)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := ReadCollection[API](ctx, d.ProviderData.Client, d.CollectionEndpoint, opts)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	if d.Filter != nil {
+		filtered := make([]API, 0, len(items))
+		for _, item := range items {
+			if d.Filter(ctx, model, item) {
+				filtered = append(filtered, item)
+			}
+		}
+		items = filtered
+	}
+
+	resp.Diagnostics.Append(d.FromAPI(ctx, items, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
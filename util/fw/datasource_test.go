@@ -0,0 +1,234 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fw
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jfrog/terraform-provider-shared/client"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// listServer serves 5 items under /api/items, wrapped in an `items` field and paginated with offset and limit
+func listServer(t *testing.T) *httptest.Server {
+	all := []testAPI{}
+	for i := 0; i < 5; i++ {
+		all = append(all, testAPI{Key: "key-" + strconv.Itoa(i), Description: strconv.Itoa(i % 2)})
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/items" {
+			return
+		}
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		if err != nil {
+			limit = len(all)
+		}
+		end := min(offset+limit, len(all))
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"items": all[min(offset, end):end]})
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestReadCollection(t *testing.T) {
+	server := listServer(t)
+	restyClient, err := client.Build(server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]int{
+		"not paginated": 0,
+		"page size 2":   2,
+		"page size 5":   5,
+		"page larger":   10,
+		"page size 1":   1,
+	}
+
+	for name, pageSize := range testCases {
+		t.Run(name, func(t *testing.T) {
+			items, err := ReadCollection[testAPI](context.Background(), restyClient, "api/items", ListOptions{
+				ItemsField: "items",
+				PageSize:   pageSize,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(items) != 5 {
+				t.Errorf("Incorrect item count. Expected 5: got: %d", len(items))
+			}
+		})
+	}
+}
+
+func TestReadCollection_endpointIgnoresPagination(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"key":"key-0"},{"key":"key-1"}]`))
+	}))
+	defer server.Close()
+
+	restyClient, err := client.Build(server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	items, err := ReadCollection[testAPI](context.Background(), restyClient, "api/items", ListOptions{PageSize: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(items) != 2 {
+		t.Errorf("Incorrect item count. Expected 2: got: %d", len(items))
+	}
+	if requests != 2 {
+		t.Errorf("Incorrect request count. Expected 2: got: %d", requests)
+	}
+}
+
+func TestReadCollection_maxPages(t *testing.T) {
+	// serves full pages forever
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]testAPI{{Key: "key-" + r.URL.Query().Get("offset")}})
+	}))
+	defer server.Close()
+
+	restyClient, err := client.Build(server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = ReadCollection[testAPI](context.Background(), restyClient, "api/items", ListOptions{PageSize: 1, MaxPages: 3})
+	if err == nil {
+		t.Fatal("expected error, got no error")
+	}
+
+	expected := "failed to read api/items: more than 3 pages of 1 items"
+	if err.Error() != expected {
+		t.Errorf("Incorrect error. Expected %s: got: %s", expected, err)
+	}
+}
+
+func TestReadCollection_maxPagesFollowedByEmptyPage(t *testing.T) {
+	// serves 3 full pages, then an empty one
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		items := []testAPI{}
+		if offset, _ := strconv.Atoi(r.URL.Query().Get("offset")); offset < 3 {
+			items = append(items, testAPI{Key: "key-" + strconv.Itoa(offset)})
+		}
+		json.NewEncoder(w).Encode(items)
+	}))
+	defer server.Close()
+
+	restyClient, err := client.Build(server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	items, err := ReadCollection[testAPI](context.Background(), restyClient, "api/items", ListOptions{PageSize: 1, MaxPages: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(items) != 3 {
+		t.Errorf("Incorrect item count. Expected 3: got: %d", len(items))
+	}
+}
+
+func TestJFrogListDataSource_Read(t *testing.T) {
+	type listModel struct {
+		Description types.String `tfsdk:"description"`
+		Keys        types.List   `tfsdk:"keys"`
+	}
+
+	ctx := context.Background()
+	server := listServer(t)
+	restyClient, err := client.Build(server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	d := &JFrogListDataSource[listModel, testAPI]{
+		JFrogDataSource: util.JFrogDataSource{
+			TypeName:           "test_items",
+			CollectionEndpoint: "api/items",
+			ProviderData:       &util.ProviderMetadata{Client: restyClient, ProductId: "test"},
+		},
+		ItemsField: "items",
+		PageSize:   2,
+		Filter: func(ctx context.Context, model listModel, item testAPI) bool {
+			return item.Description == model.Description.ValueString()
+		},
+		FromAPI: func(ctx context.Context, items []testAPI, model *listModel) diag.Diagnostics {
+			keys := []string{}
+			for _, item := range items {
+				keys = append(keys, item.Key)
+			}
+			var diags diag.Diagnostics
+			model.Keys, diags = types.ListValueFrom(ctx, types.StringType, keys)
+			return diags
+		},
+	}
+
+	listSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{Required: true},
+			"keys":        schema.ListAttribute{ElementType: types.StringType, Computed: true},
+		},
+	}
+	objectType := listSchema.Type().TerraformType(ctx)
+	raw := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"description": tftypes.NewValue(tftypes.String, "0"),
+		"keys":        tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+	})
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: listSchema, Raw: raw}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: listSchema, Raw: raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var state listModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	var keys []string
+	state.Keys.ElementsAs(ctx, &keys, false)
+
+	expected := []string{"key-0", "key-2", "key-4"}
+	if len(keys) != len(expected) || keys[0] != expected[0] || keys[2] != expected[2] {
+		t.Errorf("Incorrect keys. Expected %v: got: %v", expected, keys)
+	}
+}
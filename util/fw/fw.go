@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -85,3 +86,12 @@ func UnableToDeleteResourceError(resp *resource.DeleteResponse, err string) {
 			"Error: "+err,
 	)
 }
+
+func UnableToReadDataSourceError(resp *datasource.ReadResponse, err string) {
+	resp.Diagnostics.AddError(
+		"Unable to Read Data Source",
+		"An unexpected error occurred while attempting to read the data source. "+
+			"Please retry the operation or report this issue to the provider developers.\n\n"+
+			"Error: "+err,
+	)
+}
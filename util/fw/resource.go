@@ -0,0 +1,388 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fw
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/jfrog/terraform-provider-shared/client"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// Hook runs before or after an API call of JFrogResource. api is nil for Read and Delete pre hooks.
+type Hook[Model any, API any] func(ctx context.Context, client *resty.Client, model *Model, api *API) diag.Diagnostics
+
+type Hooks[Model any, API any] struct {
+	PreCreate  Hook[Model, API]
+	PostCreate Hook[Model, API]
+	PostRead   Hook[Model, API]
+	PreUpdate  Hook[Model, API]
+	PostUpdate Hook[Model, API]
+	PreDelete  Hook[Model, API]
+	PostDelete Hook[Model, API]
+}
+
+// JFrogResource implements Create, Read, Update and Delete against DocumentEndpoint and CollectionEndpoint, with
+// Model the Terraform model and API the JSON payload. Endpoint path parameters, e.g. `{key}` or `{project}`, are
+// filled from PathParams. Resources embed it and add the Schema method.
+type JFrogResource[Model any, API any] struct {
+	util.JFrogResource
+
+	// ToAPI converts the plan to the API payload
+	ToAPI func(ctx context.Context, model Model) (API, diag.Diagnostics)
+	// FromAPI updates the state from the API payload
+	FromAPI func(ctx context.Context, api API, model *Model) diag.Diagnostics
+	// PathParams returns the endpoint path parameters. Defaults to the model attributes with the same names as
+	// the parameters, e.g. `{key}` is the value of the `key` attribute.
+	PathParams func(ctx context.Context, model Model) (map[string]string, diag.Diagnostics)
+
+	// CreateMethod defaults to POST to CollectionEndpoint, or PUT to DocumentEndpoint without CollectionEndpoint
+	CreateMethod string
+	// UpdateMethod defaults to PUT to DocumentEndpoint
+	UpdateMethod string
+	// RefreshAfterWrite reads the resource after Create and Update, e.g. to set computed attributes
+	RefreshAfterWrite bool
+
+	Hooks Hooks[Model, API]
+}
+
+var pathParamRegex = regexp.MustCompile(`{([^{}]+)}`)
+
+func (r *JFrogResource[Model, API]) pathParams(ctx context.Context, model Model) (map[string]string, diag.Diagnostics) {
+	if r.PathParams != nil {
+		return r.PathParams(ctx, model)
+	}
+	return modelPathParams(model, r.DocumentEndpoint, r.CollectionEndpoint), nil
+}
+
+// modelPathParams returns the values of the model attributes named like the path parameters of the endpoints
+func modelPathParams(model any, endpoints ...string) map[string]string {
+	params := map[string]string{}
+
+	value := reflect.ValueOf(model)
+	if value.Kind() == reflect.Pointer {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return params
+	}
+
+	for _, endpoint := range endpoints {
+		for _, match := range pathParamRegex.FindAllStringSubmatch(endpoint, -1) {
+			name := match[1]
+			if _, ok := params[name]; ok {
+				continue
+			}
+
+			field, ok := fieldByTag(value, name)
+			if !ok {
+				continue
+			}
+
+			if v, ok := field.Interface().(attr.Value); ok {
+				params[name] = AttributeValueToString(v)
+			} else {
+				params[name] = fmt.Sprintf("%v", field.Interface())
+			}
+		}
+	}
+
+	return params
+}
+
+func fieldByTag(value reflect.Value, tag string) (reflect.Value, bool) {
+	for i := 0; i < value.NumField(); i++ {
+		if value.Type().Field(i).Tag.Get("tfsdk") == tag {
+			return value.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+func runHook[Model any, API any](ctx context.Context, hook Hook[Model, API], client *resty.Client, model *Model, api *API) diag.Diagnostics {
+	if hook == nil {
+		return nil
+	}
+	return hook(ctx, client, model, api)
+}
+
+// read refreshes the model from DocumentEndpoint. It returns false if the resource is not found, and an error if
+// the API call failed.
+func (r *JFrogResource[Model, API]) read(ctx context.Context, model *Model) (bool, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+
+	params, d := r.pathParams(ctx, *model)
+	diags.Append(d...)
+	if diags.HasError() {
+		return false, diags, nil
+	}
+
+	var api API
+	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
+		SetPathParams(params).
+		SetResult(&api).
+		Get(r.DocumentEndpoint)
+	if err := client.CheckResponse(response, err); err != nil {
+		if client.IsNotFound(err) {
+			return false, diags, nil
+		}
+		return false, diags, err
+	}
+
+	diags.Append(r.FromAPI(ctx, api, model)...)
+	if diags.HasError() {
+		return false, diags, nil
+	}
+
+	diags.Append(runHook(ctx, r.Hooks.PostRead, r.ProviderData.Client, model, &api)...)
+
+	return true, diags, nil
+}
+
+func (r *JFrogResource[Model, API]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.ProviderData.RecordUsage(ctx, util.ResourceFeatureUsage(r.TypeName, "CREATE"))
+
+	var plan Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := r.ToAPI(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(runHook(ctx, r.Hooks.PreCreate, r.ProviderData.Client, &plan, &api)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := r.pathParams(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	method, endpoint := r.CreateMethod, r.CollectionEndpoint
+	if endpoint == "" {
+		endpoint = r.DocumentEndpoint
+		if method == "" {
+			method = http.MethodPut
+		}
+	}
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
+		SetPathParams(params).
+		SetBody(api).
+		Execute(method, endpoint)
+	if err := client.CheckResponse(response, err); err != nil {
+		UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(runHook(ctx, r.Hooks.PostCreate, r.ProviderData.Client, &plan, &api)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.RefreshAfterWrite {
+		found, diags, err := r.read(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		if err != nil {
+			UnableToCreateResourceError(resp, err.Error())
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !found {
+			UnableToCreateResourceError(resp, "resource not found after creation")
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *JFrogResource[Model, API]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.ProviderData.RecordUsage(ctx, util.ResourceFeatureUsage(r.TypeName, "READ"))
+
+	var state Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags, err := r.read(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Treat HTTP 404 Not Found status as a signal to recreate resource
+	// and return early
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *JFrogResource[Model, API]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.ProviderData.RecordUsage(ctx, util.ResourceFeatureUsage(r.TypeName, "UPDATE"))
+
+	var plan Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := r.ToAPI(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(runHook(ctx, r.Hooks.PreUpdate, r.ProviderData.Client, &plan, &api)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := r.pathParams(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	method := r.UpdateMethod
+	if method == "" {
+		method = http.MethodPut
+	}
+
+	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
+		SetPathParams(params).
+		SetBody(api).
+		Execute(method, r.DocumentEndpoint)
+	if err := client.CheckResponse(response, err); err != nil {
+		UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(runHook(ctx, r.Hooks.PostUpdate, r.ProviderData.Client, &plan, &api)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.RefreshAfterWrite {
+		found, diags, err := r.read(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		if err != nil {
+			UnableToUpdateResourceError(resp, err.Error())
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !found {
+			UnableToUpdateResourceError(resp, "resource not found after update")
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *JFrogResource[Model, API]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.ProviderData.RecordUsage(ctx, util.ResourceFeatureUsage(r.TypeName, "DELETE"))
+
+	var state Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(runHook(ctx, r.Hooks.PreDelete, r.ProviderData.Client, &state, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := r.pathParams(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
+		SetPathParams(params).
+		Delete(r.DocumentEndpoint)
+	// already deleted outside of Terraform is fine
+	if err := client.CheckResponse(response, err); err != nil && !client.IsNotFound(err) {
+		UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(runHook(ctx, r.Hooks.PostDelete, r.ProviderData.Client, &state, nil)...)
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+// JFrogResourceWithIdentity is a JFrogResource with resource identity, built from IdentityAttributes. Create, Read
+// and Update populate the identity.
+type JFrogResourceWithIdentity[Model any, API any] struct {
+	JFrogResource[Model, API]
+}
+
+func (r *JFrogResourceWithIdentity[Model, API]) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = r.IdentitySchemaFromAttributes()
+}
+
+func (r *JFrogResourceWithIdentity[Model, API]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.ImportResourceState(ctx, req, resp)
+}
+
+// JFrogResourceWithImportState is a JFrogResource which can be imported with an ID of ImportIDFormat
+type JFrogResourceWithImportState[Model any, API any] struct {
+	JFrogResource[Model, API]
+}
+
+func (r *JFrogResourceWithImportState[Model, API]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.ImportResourceState(ctx, req, resp)
+}
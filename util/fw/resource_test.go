@@ -0,0 +1,237 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fw
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jfrog/terraform-provider-shared/client"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+type testModel struct {
+	Key         types.String `tfsdk:"key"`
+	Description types.String `tfsdk:"description"`
+}
+
+type testAPI struct {
+	Key         string `json:"key"`
+	Description string `json:"description"`
+}
+
+var testSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"key":         schema.StringAttribute{Required: true},
+		"description": schema.StringAttribute{Optional: true},
+	},
+}
+
+// testServer stores test documents in memory under /api/test/{key}
+func testServer(t *testing.T) (*httptest.Server, map[string]testAPI) {
+	var mu sync.Mutex
+	documents := map[string]testAPI{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.URL.Path == "/artifactory/api/system/usage" {
+			return
+		}
+
+		key := strings.TrimPrefix(r.URL.Path, "/api/test/")
+		switch r.Method {
+		case http.MethodGet:
+			document, ok := documents[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(document)
+		case http.MethodPut:
+			var document testAPI
+			json.NewDecoder(r.Body).Decode(&document)
+			documents[key] = document
+		case http.MethodDelete:
+			delete(documents, key)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, documents
+}
+
+func testResource(t *testing.T, url string) *JFrogResource[testModel, testAPI] {
+	restyClient, err := client.Build(url, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return &JFrogResource[testModel, testAPI]{
+		JFrogResource: util.JFrogResource{
+			TypeName:         "test_resource",
+			DocumentEndpoint: "api/test/{key}",
+			ProviderData:     &util.ProviderMetadata{Client: restyClient, ProductId: "test"},
+		},
+		ToAPI: func(ctx context.Context, model testModel) (testAPI, diag.Diagnostics) {
+			return testAPI{Key: model.Key.ValueString(), Description: model.Description.ValueString()}, nil
+		},
+		FromAPI: func(ctx context.Context, api testAPI, model *testModel) diag.Diagnostics {
+			model.Key = types.StringValue(api.Key)
+			model.Description = types.StringValue(api.Description)
+			return nil
+		},
+	}
+}
+
+func testRaw(ctx context.Context, key, description string) tftypes.Value {
+	objectType := testSchema.Type().TerraformType(ctx)
+	return tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"key":         tftypes.NewValue(tftypes.String, key),
+		"description": tftypes.NewValue(tftypes.String, description),
+	})
+}
+
+func TestJFrogResource_CRUD(t *testing.T) {
+	ctx := context.Background()
+	server, documents := testServer(t)
+	r := testResource(t, server.URL)
+
+	var preCreateCalled bool
+	r.Hooks.PreCreate = func(ctx context.Context, _ *resty.Client, model *testModel, api *testAPI) diag.Diagnostics {
+		preCreateCalled = true
+		return nil
+	}
+
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: testSchema, Raw: testRaw(ctx, "my-key", "created")}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: testSchema, Raw: testRaw(ctx, "my-key", "created")}}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+	if documents["my-key"].Description != "created" {
+		t.Errorf("Incorrect document. Expected created: got: %v", documents["my-key"])
+	}
+	if !preCreateCalled {
+		t.Error("expected PreCreate hook to be called")
+	}
+
+	// change outside of Terraform
+	documents["my-key"] = testAPI{Key: "my-key", Description: "drifted"}
+
+	readResp := &resource.ReadResponse{State: tfsdk.State{Schema: testSchema, Raw: testRaw(ctx, "my-key", "created")}}
+	r.Read(ctx, resource.ReadRequest{State: tfsdk.State{Schema: testSchema, Raw: testRaw(ctx, "my-key", "created")}}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+	}
+	var state testModel
+	readResp.State.Get(ctx, &state)
+	if state.Description.ValueString() != "drifted" {
+		t.Errorf("Incorrect description. Expected drifted: got: %s", state.Description.ValueString())
+	}
+
+	updateResp := &resource.UpdateResponse{State: tfsdk.State{Schema: testSchema, Raw: testRaw(ctx, "my-key", "drifted")}}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan{Schema: testSchema, Raw: testRaw(ctx, "my-key", "updated")}}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", updateResp.Diagnostics)
+	}
+	if documents["my-key"].Description != "updated" {
+		t.Errorf("Incorrect document. Expected updated: got: %v", documents["my-key"])
+	}
+
+	deleteResp := &resource.DeleteResponse{}
+	r.Delete(ctx, resource.DeleteRequest{State: tfsdk.State{Schema: testSchema, Raw: testRaw(ctx, "my-key", "updated")}}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", deleteResp.Diagnostics)
+	}
+	if _, ok := documents["my-key"]; ok {
+		t.Error("expected document to be deleted")
+	}
+}
+
+func TestJFrogResource_Read_notFound(t *testing.T) {
+	ctx := context.Background()
+	server, _ := testServer(t)
+	r := testResource(t, server.URL)
+
+	resp := &resource.ReadResponse{State: tfsdk.State{Schema: testSchema, Raw: testRaw(ctx, "missing", "")}}
+	r.Read(ctx, resource.ReadRequest{State: tfsdk.State{Schema: testSchema, Raw: testRaw(ctx, "missing", "")}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	if !resp.State.Raw.IsNull() {
+		t.Error("expected resource to be removed from state")
+	}
+}
+
+func TestJFrogResourceWithIdentity(t *testing.T) {
+	ctx := context.Background()
+	server, _ := testServer(t)
+	r := &JFrogResourceWithIdentity[testModel, testAPI]{JFrogResource: *testResource(t, server.URL)}
+	r.IdentityAttributes = []util.IdentityAttribute{{Name: "key"}}
+
+	identitySchemaResp := &resource.IdentitySchemaResponse{}
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identitySchemaResp)
+	identitySchema := identitySchemaResp.IdentitySchema
+	if diags := identitySchema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	identityType := identitySchema.Type().TerraformType(ctx)
+
+	createResp := &resource.CreateResponse{
+		State:    tfsdk.State{Schema: testSchema, Raw: testRaw(ctx, "my-key", "created")},
+		Identity: &tfsdk.ResourceIdentity{Schema: identitySchema, Raw: tftypes.NewValue(identityType, nil)},
+	}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: testSchema, Raw: testRaw(ctx, "my-key", "created")}}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+
+	var identityKey string
+	createResp.Identity.GetAttribute(ctx, path.Root("key"), &identityKey)
+	if identityKey != "my-key" {
+		t.Errorf("Incorrect identity key. Expected my-key: got: %s", identityKey)
+	}
+
+	importResp := &resource.ImportStateResponse{
+		State:    tfsdk.State{Schema: testSchema, Raw: tftypes.NewValue(testSchema.Type().TerraformType(ctx), nil)},
+		Identity: createResp.Identity,
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{Identity: createResp.Identity}, importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", importResp.Diagnostics)
+	}
+
+	var stateKey string
+	importResp.State.GetAttribute(ctx, path.Root("key"), &stateKey)
+	if stateKey != "my-key" {
+		t.Errorf("Incorrect state key. Expected my-key: got: %s", stateKey)
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fw
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// AddTelemetry decorates the resources to report `Resource/<name>/<VERB>` usage for Create, Read, Update, Delete
+// and ImportState, the framework equivalent of sdk.AddTelemetry. The decorated resources implement import and
+// identity only when the resources do.
+func AddTelemetry(resources []func() resource.Resource) []func() resource.Resource {
+	wrapped := make([]func() resource.Resource, 0, len(resources))
+	for _, factory := range resources {
+		wrapped = append(wrapped, func() resource.Resource {
+			return withTelemetry(factory())
+		})
+	}
+	return wrapped
+}
+
+// AddDataSourceTelemetry decorates the data sources to report `DataSource/<name>/READ` usage
+func AddDataSourceTelemetry(dataSources []func() datasource.DataSource) []func() datasource.DataSource {
+	wrapped := make([]func() datasource.DataSource, 0, len(dataSources))
+	for _, factory := range dataSources {
+		wrapped = append(wrapped, func() datasource.DataSource {
+			return withDataSourceTelemetry(factory())
+		})
+	}
+	return wrapped
+}
+
+// withTelemetry wraps the resource in the variant implementing ResourceWithImportState and ResourceWithIdentity
+// only when the resource does, as the framework behaves differently for resources implementing them, e.g. it
+// expects an identity from resources implementing ResourceWithIdentity. Other optional interfaces are always
+// implemented, and do nothing for resources which don't implement them.
+func withTelemetry(r resource.Resource) resource.Resource {
+	t := &telemetryResource{Resource: r}
+
+	_, importer := r.(resource.ResourceWithImportState)
+	_, identity := r.(resource.ResourceWithIdentity)
+
+	switch {
+	case importer && identity:
+		return &telemetryResourceWithImportStateAndIdentity{t, resourceImportState{t}, resourceIdentity{t}}
+	case importer:
+		return &telemetryResourceWithImportState{t, resourceImportState{t}}
+	case identity:
+		return &telemetryResourceWithIdentity{t, resourceIdentity{t}}
+	default:
+		return t
+	}
+}
+
+func withDataSourceTelemetry(d datasource.DataSource) datasource.DataSource {
+	return &telemetryDataSource{DataSource: d}
+}
+
+var (
+	_ resource.ResourceWithConfigure        = &telemetryResource{}
+	_ resource.ResourceWithModifyPlan       = &telemetryResource{}
+	_ resource.ResourceWithMoveState        = &telemetryResource{}
+	_ resource.ResourceWithUpgradeState     = &telemetryResource{}
+	_ resource.ResourceWithValidateConfig   = &telemetryResource{}
+	_ resource.ResourceWithConfigValidators = &telemetryResource{}
+)
+
+type telemetryResource struct {
+	resource.Resource
+
+	typeName     string
+	providerData *util.ProviderMetadata
+}
+
+func (r *telemetryResource) record(ctx context.Context, verb string) {
+	if r.providerData == nil {
+		return
+	}
+	r.providerData.RecordUsage(ctx, util.ResourceFeatureUsage(r.typeName, verb))
+}
+
+func (r *telemetryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	r.Resource.Metadata(ctx, req, resp)
+	r.typeName = resp.TypeName
+}
+
+func (r *telemetryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if m, ok := req.ProviderData.(util.ProviderMetadata); ok {
+		r.providerData = &m
+	}
+
+	if rc, ok := r.Resource.(resource.ResourceWithConfigure); ok {
+		rc.Configure(ctx, req, resp)
+	}
+}
+
+func (r *telemetryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.record(ctx, "CREATE")
+	r.Resource.Create(ctx, req, resp)
+}
+
+func (r *telemetryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.record(ctx, "READ")
+	r.Resource.Read(ctx, req, resp)
+}
+
+func (r *telemetryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.record(ctx, "UPDATE")
+	r.Resource.Update(ctx, req, resp)
+}
+
+func (r *telemetryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.record(ctx, "DELETE")
+	r.Resource.Delete(ctx, req, resp)
+}
+
+func (r *telemetryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if rm, ok := r.Resource.(resource.ResourceWithModifyPlan); ok {
+		rm.ModifyPlan(ctx, req, resp)
+	}
+}
+
+func (r *telemetryResource) MoveState(ctx context.Context) []resource.StateMover {
+	if rm, ok := r.Resource.(resource.ResourceWithMoveState); ok {
+		return rm.MoveState(ctx)
+	}
+	return nil
+}
+
+func (r *telemetryResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	if ru, ok := r.Resource.(resource.ResourceWithUpgradeState); ok {
+		return ru.UpgradeState(ctx)
+	}
+	return nil
+}
+
+func (r *telemetryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if rv, ok := r.Resource.(resource.ResourceWithValidateConfig); ok {
+		rv.ValidateConfig(ctx, req, resp)
+	}
+}
+
+func (r *telemetryResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if rc, ok := r.Resource.(resource.ResourceWithConfigValidators); ok {
+		return rc.ConfigValidators(ctx)
+	}
+	return nil
+}
+
+type resourceImportState struct{ r *telemetryResource }
+
+func (f resourceImportState) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	f.r.record(ctx, "IMPORT")
+	f.r.Resource.(resource.ResourceWithImportState).ImportState(ctx, req, resp)
+}
+
+type resourceIdentity struct{ r *telemetryResource }
+
+func (f resourceIdentity) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	f.r.Resource.(resource.ResourceWithIdentity).IdentitySchema(ctx, req, resp)
+}
+
+func (f resourceIdentity) UpgradeIdentity(ctx context.Context) map[int64]resource.IdentityUpgrader {
+	if ru, ok := f.r.Resource.(resource.ResourceWithUpgradeIdentity); ok {
+		return ru.UpgradeIdentity(ctx)
+	}
+	return nil
+}
+
+var (
+	_ resource.ResourceWithImportState = &telemetryResourceWithImportState{}
+	_ resource.ResourceWithIdentity    = &telemetryResourceWithIdentity{}
+	_ resource.ResourceWithImportState = &telemetryResourceWithImportStateAndIdentity{}
+	_ resource.ResourceWithIdentity    = &telemetryResourceWithImportStateAndIdentity{}
+)
+
+type telemetryResourceWithImportState struct {
+	*telemetryResource
+	resourceImportState
+}
+
+type telemetryResourceWithIdentity struct {
+	*telemetryResource
+	resourceIdentity
+}
+
+type telemetryResourceWithImportStateAndIdentity struct {
+	*telemetryResource
+	resourceImportState
+	resourceIdentity
+}
+
+var (
+	_ datasource.DataSourceWithConfigure        = &telemetryDataSource{}
+	_ datasource.DataSourceWithValidateConfig   = &telemetryDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &telemetryDataSource{}
+)
+
+type telemetryDataSource struct {
+	datasource.DataSource
+
+	typeName     string
+	providerData *util.ProviderMetadata
+}
+
+func (d *telemetryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	d.DataSource.Metadata(ctx, req, resp)
+	d.typeName = resp.TypeName
+}
+
+func (d *telemetryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if m, ok := req.ProviderData.(util.ProviderMetadata); ok {
+		d.providerData = &m
+	}
+
+	if dc, ok := d.DataSource.(datasource.DataSourceWithConfigure); ok {
+		dc.Configure(ctx, req, resp)
+	}
+}
+
+func (d *telemetryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.providerData != nil {
+		d.providerData.RecordUsage(ctx, util.DataSourceFeatureUsage(d.typeName, "READ"))
+	}
+	d.DataSource.Read(ctx, req, resp)
+}
+
+func (d *telemetryDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	if dv, ok := d.DataSource.(datasource.DataSourceWithValidateConfig); ok {
+		dv.ValidateConfig(ctx, req, resp)
+	}
+}
+
+func (d *telemetryDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	if dc, ok := d.DataSource.(datasource.DataSourceWithConfigValidators); ok {
+		return dc.ConfigValidators(ctx)
+	}
+	return nil
+}
@@ -0,0 +1,280 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fw
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/jfrog/terraform-provider-shared/client"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// plainResource is a hand-written resource without usage reporting of its own
+type plainResource struct{}
+
+func (r *plainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plain"
+}
+
+func (r *plainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = testSchema
+}
+
+func (r *plainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+}
+
+func (r *plainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+func (r *plainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+func (r *plainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+type importableResource struct {
+	plainResource
+}
+
+func (r *importableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+}
+
+type identityResource struct {
+	importableResource
+}
+
+func (r *identityResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"key": identityschema.StringAttribute{RequiredForImport: true},
+		},
+	}
+}
+
+type identityOnlyResource struct {
+	plainResource
+}
+
+func (r *identityOnlyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	(&identityResource{}).IdentitySchema(ctx, req, resp)
+}
+
+type planResource struct {
+	plainResource
+}
+
+func (r *planResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.AddWarning("modified", "")
+}
+
+func (r *planResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return nil
+}
+
+type plainDataSource struct{}
+
+func (d *plainDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plain"
+}
+
+func (d *plainDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+}
+
+func (d *plainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+}
+
+// usageServer records the features of the usage requests
+func usageServer(t *testing.T) (*httptest.Server, func() []string) {
+	var mu sync.Mutex
+	var features []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/artifactory/api/system/usage" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var usage util.UsageStruct
+		json.NewDecoder(r.Body).Decode(&usage)
+
+		mu.Lock()
+		defer mu.Unlock()
+		for _, feature := range usage.Features {
+			features = append(features, feature.FeatureId)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		sort.Strings(features)
+		return features
+	}
+}
+
+func testProviderMetadata(t *testing.T, url string) util.ProviderMetadata {
+	restyClient, err := client.Build(url, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	telemetry := util.NewTelemetry(restyClient, "test", false)
+	telemetry.FlushDelay = time.Hour
+	return util.ProviderMetadata{Client: restyClient, ProductId: "test", Telemetry: telemetry}
+}
+
+func TestAddTelemetry(t *testing.T) {
+	ctx := context.Background()
+	server, features := usageServer(t)
+	meta := testProviderMetadata(t, server.URL)
+
+	r := AddTelemetry([]func() resource.Resource{
+		func() resource.Resource { return &importableResource{} },
+	})[0]()
+
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "test"}, &resource.MetadataResponse{})
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: meta}, &resource.ConfigureResponse{})
+	r.Create(ctx, resource.CreateRequest{}, &resource.CreateResponse{})
+	r.Read(ctx, resource.ReadRequest{}, &resource.ReadResponse{})
+	r.Update(ctx, resource.UpdateRequest{}, &resource.UpdateResponse{})
+	r.Delete(ctx, resource.DeleteRequest{}, &resource.DeleteResponse{})
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "my-key"}, &resource.ImportStateResponse{})
+
+	meta.Telemetry.Flush(ctx)
+
+	expected := []string{
+		"Partner/ACC-007450",
+		"Resource/test_plain/CREATE",
+		"Resource/test_plain/DELETE",
+		"Resource/test_plain/IMPORT",
+		"Resource/test_plain/READ",
+		"Resource/test_plain/UPDATE",
+	}
+	if got := features(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Incorrect features. Expected %v: got: %v", expected, got)
+	}
+}
+
+// implementedInterfaces returns the optional resource interfaces implemented by r
+func implementedInterfaces(r resource.Resource) []bool {
+	return []bool{
+		implements[resource.ResourceWithImportState](r),
+		implements[resource.ResourceWithModifyPlan](r),
+		implements[resource.ResourceWithMoveState](r),
+		implements[resource.ResourceWithUpgradeState](r),
+		implements[resource.ResourceWithValidateConfig](r),
+		implements[resource.ResourceWithConfigValidators](r),
+		implements[resource.ResourceWithIdentity](r),
+		implements[resource.ResourceWithUpgradeIdentity](r),
+	}
+}
+
+func implements[T any](v interface{}) bool {
+	_, ok := v.(T)
+	return ok
+}
+
+func TestAddTelemetry_optionalInterfaces(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		resource resource.Resource
+		expected []bool
+	}{
+		"plain": {
+			resource: &plainResource{},
+			expected: []bool{false, true, true, true, true, true, false, false},
+		},
+		"import": {
+			resource: &importableResource{},
+			expected: []bool{true, true, true, true, true, true, false, false},
+		},
+		"identity": {
+			resource: &identityResource{},
+			expected: []bool{true, true, true, true, true, true, true, true},
+		},
+		"identity without import": {
+			resource: &identityOnlyResource{},
+			expected: []bool{false, true, true, true, true, true, true, true},
+		},
+		"plan": {
+			resource: &planResource{},
+			expected: []bool{false, true, true, true, true, true, false, false},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := AddTelemetry([]func() resource.Resource{
+				func() resource.Resource { return testCase.resource },
+			})[0]()
+
+			if got := implementedInterfaces(r); !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("Incorrect interfaces. Expected %v: got: %v", testCase.expected, got)
+			}
+
+			// forwarded when implemented, and a no-op otherwise
+			_, modifiesPlan := testCase.resource.(resource.ResourceWithModifyPlan)
+			expectedWarnings := 0
+			if modifiesPlan {
+				expectedWarnings = 1
+			}
+
+			resp := &resource.ModifyPlanResponse{}
+			r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{}, resp)
+			if resp.Diagnostics.WarningsCount() != expectedWarnings {
+				t.Errorf("Incorrect ModifyPlan warnings. Expected %d: got: %v", expectedWarnings, resp.Diagnostics)
+			}
+
+			if upgraders := r.(resource.ResourceWithUpgradeState).UpgradeState(ctx); upgraders != nil {
+				t.Errorf("Incorrect state upgraders. Expected none: got: %v", upgraders)
+			}
+		})
+	}
+}
+
+func TestAddDataSourceTelemetry(t *testing.T) {
+	ctx := context.Background()
+	server, features := usageServer(t)
+	meta := testProviderMetadata(t, server.URL)
+
+	d := AddDataSourceTelemetry([]func() datasource.DataSource{
+		func() datasource.DataSource { return &plainDataSource{} },
+	})[0]()
+
+	d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "test"}, &datasource.MetadataResponse{})
+	d.(datasource.DataSourceWithConfigure).Configure(ctx, datasource.ConfigureRequest{ProviderData: meta}, &datasource.ConfigureResponse{})
+	d.Read(ctx, datasource.ReadRequest{}, &datasource.ReadResponse{})
+	d.Read(ctx, datasource.ReadRequest{}, &datasource.ReadResponse{})
+
+	meta.Telemetry.Flush(ctx)
+
+	expected := []string{"DataSource/test_plain/READ", "Partner/ACC-007450"}
+	if got := features(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Incorrect features. Expected %v: got: %v", expected, got)
+	}
+}
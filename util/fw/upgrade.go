@@ -0,0 +1,282 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fw
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SDKv2StateUpgrader builds a StateUpgrader from the SDKv2 resource a framework resource replaces. The prior state
+// is converted to the current schema:
+//   - single item lists (blocks with MaxItems 1) become objects
+//   - lists and sets of strings become sets or lists
+//   - comma separated strings, e.g. from sdk.FormatCommaSeparatedString, become sets or lists of strings
+//   - attributes missing from the current schema are removed, new attributes are null
+func SDKv2StateUpgrader(prior *sdkschema.Resource) resource.StateUpgrader {
+	priorSchema := SDKv2PriorSchema(prior)
+
+	return resource.StateUpgrader{
+		PriorSchema: &priorSchema,
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			targetType := resp.State.Schema.Type().TerraformType(ctx)
+
+			upgraded, err := upgradeValue(req.State.Raw, targetType)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					"An unexpected error occurred while upgrading the resource state from the SDKv2 schema. "+
+						"Please report this issue to the provider developers.\n\n"+
+						"Error: "+err.Error(),
+				)
+				return
+			}
+
+			resp.State.Raw = upgraded
+		},
+	}
+}
+
+// SDKv2PriorSchema converts an SDKv2 resource schema into a framework schema, for StateUpgrader.PriorSchema. It is
+// built from prior.CoreConfigSchema(), as served by the SDKv2 provider, so it has the implicit id attribute, the
+// timeouts block and computed-only nested resources as attributes, like SDKv2 stores them. Other nested resources
+// become blocks.
+func SDKv2PriorSchema(prior *sdkschema.Resource) schema.Schema {
+	const typeName = "prior"
+
+	server := sdkschema.NewGRPCProviderServer(&sdkschema.Provider{
+		ResourcesMap: map[string]*sdkschema.Resource{typeName: prior},
+	})
+	// the schema response has no error diagnostics for resources
+	resp, _ := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+
+	attributes, blocks := sdkv2Attributes(resp.ResourceSchemas[typeName].Block)
+
+	return schema.Schema{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+}
+
+func sdkv2Attributes(block *tfprotov5.SchemaBlock) (map[string]schema.Attribute, map[string]schema.Block) {
+	attributes := map[string]schema.Attribute{}
+	blocks := map[string]schema.Block{}
+
+	for _, a := range block.Attributes {
+		switch attributeType := sdkv2Type(a.Type).(type) {
+		case types.ListType:
+			attributes[a.Name] = schema.ListAttribute{ElementType: attributeType.ElemType, Optional: true, Computed: true}
+		case types.SetType:
+			attributes[a.Name] = schema.SetAttribute{ElementType: attributeType.ElemType, Optional: true, Computed: true}
+		case types.MapType:
+			attributes[a.Name] = schema.MapAttribute{ElementType: attributeType.ElemType, Optional: true, Computed: true}
+		case types.ObjectType:
+			attributes[a.Name] = schema.ObjectAttribute{AttributeTypes: attributeType.AttrTypes, Optional: true, Computed: true}
+		case basetypes.BoolType:
+			attributes[a.Name] = schema.BoolAttribute{Optional: true, Computed: true}
+		case basetypes.NumberType:
+			attributes[a.Name] = schema.NumberAttribute{Optional: true, Computed: true}
+		case basetypes.DynamicType:
+			attributes[a.Name] = schema.DynamicAttribute{Optional: true, Computed: true}
+		default:
+			attributes[a.Name] = schema.StringAttribute{Optional: true, Computed: true}
+		}
+	}
+
+	for _, b := range block.BlockTypes {
+		nestedAttributes, nestedBlocks := sdkv2Attributes(b.Block)
+
+		switch b.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList:
+			blocks[b.TypeName] = schema.ListNestedBlock{NestedObject: schema.NestedBlockObject{Attributes: nestedAttributes, Blocks: nestedBlocks}}
+		case tfprotov5.SchemaNestedBlockNestingModeSet:
+			blocks[b.TypeName] = schema.SetNestedBlock{NestedObject: schema.NestedBlockObject{Attributes: nestedAttributes, Blocks: nestedBlocks}}
+		default:
+			// e.g. timeouts
+			blocks[b.TypeName] = schema.SingleNestedBlock{Attributes: nestedAttributes, Blocks: nestedBlocks}
+		}
+	}
+
+	return attributes, blocks
+}
+
+func sdkv2Type(t tftypes.Type) attr.Type {
+	switch t := t.(type) {
+	case tftypes.List:
+		return types.ListType{ElemType: sdkv2Type(t.ElementType)}
+	case tftypes.Set:
+		return types.SetType{ElemType: sdkv2Type(t.ElementType)}
+	case tftypes.Map:
+		return types.MapType{ElemType: sdkv2Type(t.ElementType)}
+	case tftypes.Object:
+		attributeTypes := map[string]attr.Type{}
+		for name, attributeType := range t.AttributeTypes {
+			attributeTypes[name] = sdkv2Type(attributeType)
+		}
+		return types.ObjectType{AttrTypes: attributeTypes}
+	}
+
+	switch {
+	case t.Is(tftypes.Bool):
+		return types.BoolType
+	case t.Is(tftypes.Number):
+		return types.NumberType
+	case t.Is(tftypes.DynamicPseudoType):
+		return types.DynamicType
+	}
+
+	return types.StringType
+}
+
+// upgradeValue converts a prior state value to the target type
+func upgradeValue(value tftypes.Value, target tftypes.Type) (tftypes.Value, error) {
+	if value.Type().Equal(target) {
+		return value, nil
+	}
+
+	if value.IsNull() || !value.IsKnown() {
+		return tftypes.NewValue(target, nil), nil
+	}
+
+	switch {
+	case target.Is(tftypes.Object{}):
+		return upgradeObject(value, target.(tftypes.Object))
+	case target.Is(tftypes.List{}), target.Is(tftypes.Set{}):
+		return upgradeCollection(value, target)
+	case target.Is(tftypes.Number), target.Is(tftypes.String), target.Is(tftypes.Bool):
+		// a single item list of a primitive
+		if value.Type().Is(tftypes.List{}) || value.Type().Is(tftypes.Set{}) {
+			var elements []tftypes.Value
+			if err := value.As(&elements); err != nil {
+				return tftypes.Value{}, err
+			}
+			if len(elements) == 0 {
+				return tftypes.NewValue(target, nil), nil
+			}
+			return upgradeValue(elements[0], target)
+		}
+	}
+
+	return tftypes.Value{}, fmt.Errorf("cannot convert %s to %s", value.Type(), target)
+}
+
+func upgradeObject(value tftypes.Value, target tftypes.Object) (tftypes.Value, error) {
+	// single item list becomes an object
+	if value.Type().Is(tftypes.List{}) || value.Type().Is(tftypes.Set{}) {
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return tftypes.Value{}, err
+		}
+		if len(elements) == 0 {
+			return tftypes.NewValue(target, nil), nil
+		}
+		return upgradeValue(elements[0], target)
+	}
+
+	if !value.Type().Is(tftypes.Object{}) {
+		return tftypes.Value{}, fmt.Errorf("cannot convert %s to %s", value.Type(), target)
+	}
+
+	var priorAttributes map[string]tftypes.Value
+	if err := value.As(&priorAttributes); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	// attributes missing from the target are removed
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range target.AttributeTypes {
+		prior, ok := priorAttributes[name]
+		if !ok {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+			continue
+		}
+
+		upgraded, err := upgradeValue(prior, attributeType)
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+		}
+		attributes[name] = upgraded
+	}
+
+	return tftypes.NewValue(target, attributes), nil
+}
+
+func upgradeCollection(value tftypes.Value, target tftypes.Type) (tftypes.Value, error) {
+	var elementType tftypes.Type
+	if list, ok := target.(tftypes.List); ok {
+		elementType = list.ElementType
+	} else {
+		elementType = target.(tftypes.Set).ElementType
+	}
+
+	// comma separated string becomes a collection of strings
+	if value.Type().Is(tftypes.String) && elementType.Is(tftypes.String) {
+		var s string
+		if err := value.As(&s); err != nil {
+			return tftypes.Value{}, err
+		}
+
+		seen := map[string]bool{}
+		elements := []tftypes.Value{}
+		for _, item := range strings.Split(s, ",") {
+			item = strings.TrimSpace(item)
+			if item == "" || seen[item] {
+				continue
+			}
+			seen[item] = true
+			elements = append(elements, tftypes.NewValue(tftypes.String, item))
+		}
+
+		return tftypes.NewValue(target, elements), nil
+	}
+
+	if !value.Type().Is(tftypes.List{}) && !value.Type().Is(tftypes.Set{}) {
+		return tftypes.Value{}, fmt.Errorf("cannot convert %s to %s", value.Type(), target)
+	}
+
+	var priorElements []tftypes.Value
+	if err := value.As(&priorElements); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	_, isSet := target.(tftypes.Set)
+
+	elements := make([]tftypes.Value, 0, len(priorElements))
+	for _, prior := range priorElements {
+		upgraded, err := upgradeValue(prior, elementType)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		// list elements may be duplicates, set elements must not
+		if isSet && slices.ContainsFunc(elements, upgraded.Equal) {
+			continue
+		}
+		elements = append(elements, upgraded)
+	}
+
+	return tftypes.NewValue(target, elements), nil
+}
@@ -0,0 +1,203 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fw
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSDKv2StateUpgrader(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		prior         map[string]*sdkschema.Schema
+		timeouts      *sdkschema.ResourceTimeout
+		priorState    string
+		current       map[string]schema.Attribute
+		expectedState string
+	}{
+		"single item list to object": {
+			prior: map[string]*sdkschema.Schema{
+				"config": {
+					Type:     sdkschema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &sdkschema.Resource{
+						Schema: map[string]*sdkschema.Schema{
+							"name": {Type: sdkschema.TypeString, Optional: true},
+						},
+					},
+				},
+			},
+			priorState: `{"id":"test","config":[{"name":"a"}]}`,
+			current: map[string]schema.Attribute{
+				"id": schema.StringAttribute{Computed: true},
+				"config": schema.SingleNestedAttribute{
+					Optional:   true,
+					Attributes: map[string]schema.Attribute{"name": schema.StringAttribute{Optional: true}},
+				},
+			},
+			expectedState: `{"id":"test","config":{"name":"a"}}`,
+		},
+		"empty single item list to null object": {
+			prior: map[string]*sdkschema.Schema{
+				"config": {
+					Type:     sdkschema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &sdkschema.Resource{
+						Schema: map[string]*sdkschema.Schema{
+							"name": {Type: sdkschema.TypeString, Optional: true},
+						},
+					},
+				},
+			},
+			priorState: `{"id":"test","config":[]}`,
+			current: map[string]schema.Attribute{
+				"id": schema.StringAttribute{Computed: true},
+				"config": schema.SingleNestedAttribute{
+					Optional:   true,
+					Attributes: map[string]schema.Attribute{"name": schema.StringAttribute{Optional: true}},
+				},
+			},
+			expectedState: `{"id":"test","config":null}`,
+		},
+		"string set": {
+			prior: map[string]*sdkschema.Schema{
+				"repos": {Type: sdkschema.TypeSet, Optional: true, Elem: &sdkschema.Schema{Type: sdkschema.TypeString}},
+			},
+			priorState: `{"id":"test","repos":["a","b"]}`,
+			current: map[string]schema.Attribute{
+				"id":    schema.StringAttribute{Computed: true},
+				"repos": schema.SetAttribute{ElementType: types.StringType, Optional: true},
+			},
+			expectedState: `{"id":"test","repos":["a","b"]}`,
+		},
+		"string list to set": {
+			prior: map[string]*sdkschema.Schema{
+				"repos": {Type: sdkschema.TypeList, Optional: true, Elem: &sdkschema.Schema{Type: sdkschema.TypeString}},
+			},
+			priorState: `{"id":"test","repos":["a","b"]}`,
+			current: map[string]schema.Attribute{
+				"id":    schema.StringAttribute{Computed: true},
+				"repos": schema.SetAttribute{ElementType: types.StringType, Optional: true},
+			},
+			expectedState: `{"id":"test","repos":["a","b"]}`,
+		},
+		"comma separated string to set": {
+			prior: map[string]*sdkschema.Schema{
+				"includes_pattern": {Type: sdkschema.TypeString, Optional: true},
+			},
+			priorState: `{"id":"test","includes_pattern":"**/*.jar,**/*.pom"}`,
+			current: map[string]schema.Attribute{
+				"id":               schema.StringAttribute{Computed: true},
+				"includes_pattern": schema.SetAttribute{ElementType: types.StringType, Optional: true},
+			},
+			expectedState: `{"id":"test","includes_pattern":["**/*.jar","**/*.pom"]}`,
+		},
+		"string list with duplicates to set": {
+			prior: map[string]*sdkschema.Schema{
+				"repos": {Type: sdkschema.TypeList, Optional: true, Elem: &sdkschema.Schema{Type: sdkschema.TypeString}},
+			},
+			priorState: `{"id":"test","repos":["a","b","a"]}`,
+			current: map[string]schema.Attribute{
+				"id":    schema.StringAttribute{Computed: true},
+				"repos": schema.SetAttribute{ElementType: types.StringType, Optional: true},
+			},
+			expectedState: `{"id":"test","repos":["a","b"]}`,
+		},
+		"timeouts and computed nested resource": {
+			prior: map[string]*sdkschema.Schema{
+				"status": {
+					Type:     sdkschema.TypeList,
+					Computed: true,
+					Elem: &sdkschema.Resource{
+						Schema: map[string]*sdkschema.Schema{
+							"state": {Type: sdkschema.TypeString, Computed: true},
+						},
+					},
+				},
+				"priority": {Type: sdkschema.TypeInt, Optional: true},
+			},
+			timeouts:   &sdkschema.ResourceTimeout{Create: sdkschema.DefaultTimeout(time.Minute)},
+			priorState: `{"id":"test","priority":1,"status":[{"state":"ready"}],"timeouts":{"create":"5m"}}`,
+			current: map[string]schema.Attribute{
+				"id":       schema.StringAttribute{Computed: true},
+				"priority": schema.Int64Attribute{Optional: true},
+				"status": schema.SingleNestedAttribute{
+					Computed:   true,
+					Attributes: map[string]schema.Attribute{"state": schema.StringAttribute{Computed: true}},
+				},
+			},
+			expectedState: `{"id":"test","priority":1,"status":{"state":"ready"}}`,
+		},
+		"removed and new attributes": {
+			prior: map[string]*sdkschema.Schema{
+				"name":   {Type: sdkschema.TypeString, Optional: true},
+				"legacy": {Type: sdkschema.TypeBool, Optional: true},
+			},
+			priorState: `{"id":"test","name":"a","legacy":true}`,
+			current: map[string]schema.Attribute{
+				"id":          schema.StringAttribute{Computed: true},
+				"name":        schema.StringAttribute{Optional: true},
+				"description": schema.StringAttribute{Optional: true},
+			},
+			expectedState: `{"id":"test","name":"a","description":null}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			upgrader := SDKv2StateUpgrader(&sdkschema.Resource{Schema: testCase.prior, Timeouts: testCase.timeouts})
+			if diags := upgrader.PriorSchema.ValidateImplementation(ctx); diags.HasError() {
+				t.Fatalf("invalid prior schema: %v", diags)
+			}
+
+			priorType := upgrader.PriorSchema.Type().TerraformType(ctx)
+			priorValue, err := (&tfprotov6.RawState{JSON: []byte(testCase.priorState)}).Unmarshal(priorType)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			currentSchema := schema.Schema{Attributes: testCase.current}
+			currentType := currentSchema.Type().TerraformType(ctx)
+			expected, err := (&tfprotov6.RawState{JSON: []byte(testCase.expectedState)}).Unmarshal(currentType)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			req := resource.UpgradeStateRequest{State: &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: priorValue}}
+			resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: currentSchema, Raw: tftypes.NewValue(currentType, nil)}}
+			upgrader.StateUpgrader(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			if !resp.State.Raw.Equal(expected) {
+				t.Errorf("Incorrect state. Expected %s: got: %s", expected, resp.State.Raw)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IdentityAttribute declares a string attribute of the resource identity. It mirrors the state attribute with the
+// same name.
+type IdentityAttribute struct {
+	Name string
+	// OptionalForImport marks attributes, e.g. `project_key`, which may be omitted in import blocks
+	OptionalForImport bool
+	Description       string
+}
+
+// IdentitySchemaFromAttributes builds the identity schema from IdentityAttributes
+func (r *JFrogResource) IdentitySchemaFromAttributes() identityschema.Schema {
+	attributes := map[string]identityschema.Attribute{}
+	for _, attribute := range r.IdentityAttributes {
+		attributes[attribute.Name] = identityschema.StringAttribute{
+			RequiredForImport: !attribute.OptionalForImport,
+			OptionalForImport: attribute.OptionalForImport,
+			Description:       attribute.Description,
+		}
+	}
+
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// SetIdentity copies the identity attributes from the state. Resources with their own Create and Read call it
+// when the response has an Identity.
+func (r *JFrogResource) SetIdentity(ctx context.Context, state tfsdk.State, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if identity == nil {
+		return diags
+	}
+
+	for _, attribute := range r.IdentityAttributes {
+		var value types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(attribute.Name), &value)...)
+		diags.Append(identity.SetAttribute(ctx, path.Root(attribute.Name), value)...)
+	}
+
+	return diags
+}
+
+// importStateFromIdentity sets the state attributes from the identity of an import block
+func (r *JFrogResource) importStateFromIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, resp *resource.ImportStateResponse) {
+	for _, attribute := range r.IdentityAttributes {
+		var value types.String
+		resp.Diagnostics.Append(identity.GetAttribute(ctx, path.Root(attribute.Name), &value)...)
+		if value.IsNull() {
+			continue
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute.Name), value)...)
+	}
+}
+
+// JFrogResourceWithIdentity is a JFrogResource with resource identity, built from IdentityAttributes, so it can be
+// imported with an `identity` in import blocks.
+type JFrogResourceWithIdentity struct {
+	JFrogResource
+}
+
+func (r *JFrogResourceWithIdentity) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = r.IdentitySchemaFromAttributes()
+}
+
+func (r *JFrogResourceWithIdentity) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.ImportResourceState(ctx, req, resp)
+}
@@ -0,0 +1,222 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ImportIDFormat describes an import ID made of named segments and literal separators, e.g. `{project_key}/{name}`.
+// A segment ending with `?`, e.g. `{name}:{project_key?}`, is optional, together with the separator in front of it.
+// Optional segments must come last. Separators inside segment values are escaped with a backslash, e.g. `a\/b`.
+type ImportIDFormat string
+
+type importIDToken struct {
+	literal  string
+	segment  string
+	optional bool
+}
+
+func (f ImportIDFormat) tokens() ([]importIDToken, error) {
+	var tokens []importIDToken
+	format := string(f)
+	optionalSeen := false
+
+	for len(format) > 0 {
+		start := strings.Index(format, "{")
+		if start != 0 {
+			literal := format
+			if start > 0 {
+				literal = format[:start]
+			}
+			tokens = append(tokens, importIDToken{literal: literal})
+			format = format[len(literal):]
+			continue
+		}
+
+		end := strings.Index(format, "}")
+		if end < 0 {
+			return nil, fmt.Errorf("invalid import ID format %s: unclosed segment", f)
+		}
+
+		name := format[1:end]
+		optional := strings.HasSuffix(name, "?")
+		name = strings.TrimSuffix(name, "?")
+		if name == "" {
+			return nil, fmt.Errorf("invalid import ID format %s: empty segment name", f)
+		}
+		if optionalSeen && !optional {
+			return nil, fmt.Errorf("invalid import ID format %s: required segment %s after optional segment", f, name)
+		}
+		if len(tokens) > 0 && tokens[len(tokens)-1].segment != "" {
+			return nil, fmt.Errorf("invalid import ID format %s: segment %s needs a separator in front", f, name)
+		}
+		optionalSeen = optionalSeen || optional
+
+		tokens = append(tokens, importIDToken{segment: name, optional: optional})
+		format = format[end+1:]
+	}
+
+	return tokens, nil
+}
+
+// Segments returns the names of the segments, in order
+func (f ImportIDFormat) Segments() []string {
+	tokens, _ := f.tokens()
+
+	var segments []string
+	for _, token := range tokens {
+		if token.segment != "" {
+			segments = append(segments, token.segment)
+		}
+	}
+	return segments
+}
+
+// Parse splits the import ID into its segment values. Missing optional segments are absent from the result.
+func (f ImportIDFormat) Parse(id string) (map[string]string, error) {
+	tokens, err := f.tokens()
+	if err != nil {
+		return nil, err
+	}
+
+	invalid := fmt.Errorf("expected import identifier with format: %s. Got: %q", f, id)
+	values := map[string]string{}
+	rest := id
+
+	for i, token := range tokens {
+		if token.literal != "" {
+			if rest == "" && i+1 < len(tokens) && tokens[i+1].optional {
+				break
+			}
+			if !strings.HasPrefix(rest, token.literal) {
+				return nil, invalid
+			}
+			rest = rest[len(token.literal):]
+			continue
+		}
+
+		// the value runs up to the next unescaped separator
+		next := ""
+		if i+1 < len(tokens) {
+			next = tokens[i+1].literal
+		}
+
+		var value strings.Builder
+		for rest != "" {
+			if next != "" && strings.HasPrefix(rest, next) {
+				break
+			}
+			if rest[0] == '\\' && len(rest) > 1 {
+				rest = rest[1:]
+			}
+			value.WriteByte(rest[0])
+			rest = rest[1:]
+		}
+
+		if value.Len() == 0 {
+			if token.optional {
+				continue
+			}
+			return nil, invalid
+		}
+		values[token.segment] = value.String()
+	}
+
+	if rest != "" {
+		return nil, invalid
+	}
+
+	return values, nil
+}
+
+// Build joins the segment values into an import ID, escaping separators in the values
+func (f ImportIDFormat) Build(values map[string]string) (string, error) {
+	tokens, err := f.tokens()
+	if err != nil {
+		return "", err
+	}
+
+	var separators []string
+	for _, token := range tokens {
+		if token.literal != "" {
+			separators = append(separators, token.literal)
+		}
+	}
+
+	escape := func(value string) string {
+		value = strings.ReplaceAll(value, `\`, `\\`)
+		for _, separator := range separators {
+			value = strings.ReplaceAll(value, separator, `\`+separator)
+		}
+		return value
+	}
+
+	var id strings.Builder
+	for i, token := range tokens {
+		if token.literal != "" {
+			// drop the separator of a missing optional segment
+			if i+1 < len(tokens) && tokens[i+1].optional && values[tokens[i+1].segment] == "" {
+				break
+			}
+			id.WriteString(token.literal)
+			continue
+		}
+
+		value := values[token.segment]
+		if value == "" {
+			if token.optional {
+				break
+			}
+			return "", fmt.Errorf("missing value for import ID segment %s", token.segment)
+		}
+		id.WriteString(escape(value))
+	}
+
+	return id.String(), nil
+}
+
+// importValue converts an import ID segment to a value of the string, number or bool attribute type
+func importValue(ctx context.Context, attrType attr.Type, value string) (attr.Value, error) {
+	var tfValue tftypes.Value
+
+	switch tfType := attrType.TerraformType(ctx); {
+	case tfType.Is(tftypes.String):
+		tfValue = tftypes.NewValue(tftypes.String, value)
+	case tfType.Is(tftypes.Number):
+		number, _, err := big.ParseFloat(value, 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", value)
+		}
+		tfValue = tftypes.NewValue(tftypes.Number, number)
+	case tfType.Is(tftypes.Bool):
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a bool", value)
+		}
+		tfValue = tftypes.NewValue(tftypes.Bool, b)
+	default:
+		return nil, fmt.Errorf("attributes of type %s can't be imported", attrType)
+	}
+
+	return attrType.ValueFromTerraform(ctx, tfValue)
+}
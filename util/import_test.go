@@ -0,0 +1,151 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestImportIDFormat(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		format   ImportIDFormat
+		id       string
+		expected map[string]string
+	}{
+		"single segment":       {format: "{key}", id: "my-repo", expected: map[string]string{"key": "my-repo"}},
+		"two segments":         {format: "{project_key}/{name}", id: "proj/my-name", expected: map[string]string{"project_key": "proj", "name": "my-name"}},
+		"optional present":     {format: "{key}:{project_key?}", id: "my-repo:proj", expected: map[string]string{"key": "my-repo", "project_key": "proj"}},
+		"optional absent":      {format: "{key}:{project_key?}", id: "my-repo", expected: map[string]string{"key": "my-repo"}},
+		"escaped separator":    {format: "{name},{type}", id: `a\,b,local`, expected: map[string]string{"name": "a,b", "type": "local"}},
+		"escaped backslash":    {format: "{name},{type}", id: `a\\b,local`, expected: map[string]string{"name": `a\b`, "type": "local"}},
+		"multi char separator": {format: "{name}::{type}", id: "a:b::local", expected: map[string]string{"name": "a:b", "type": "local"}},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			values, err := testCase.format.Parse(testCase.id)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(values, testCase.expected) {
+				t.Errorf("Incorrect values. Expected %v: got: %v", testCase.expected, values)
+			}
+
+			id, err := testCase.format.Build(values)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if id != testCase.id {
+				t.Errorf("Incorrect import ID. Expected %s: got: %s", testCase.id, id)
+			}
+		})
+	}
+}
+
+func TestImportIDFormat_errors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		format ImportIDFormat
+		id     string
+	}{
+		"missing segment":          {format: "{project_key}/{name}", id: "proj"},
+		"empty segment":            {format: "{project_key}/{name}", id: "/name"},
+		"wrong separator":          {format: "{project_key}/{name}", id: "proj:name"},
+		"unclosed format":          {format: "{key", id: "a"},
+		"required after optional":  {format: "{a}:{b?}:{c}", id: "a:b:c"},
+		"adjacent format segments": {format: "{a}{b}", id: "ab"},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := testCase.format.Parse(testCase.id); err == nil {
+				t.Error("expected error, got no error")
+			}
+		})
+	}
+}
+
+func TestJFrogResourceWithImportState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"key":         schema.StringAttribute{Required: true},
+			"project_key": schema.StringAttribute{Optional: true},
+			"priority":    schema.Int64Attribute{Optional: true},
+			"enabled":     schema.BoolAttribute{Optional: true},
+		},
+	}
+	objectType := testSchema.Type().TerraformType(ctx)
+
+	type importer interface {
+		ImportState(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse)
+	}
+	var _ importer = &JFrogResourceWithImportState{}
+	var _ importer = &JFrogResourceWithIdentity{}
+	if _, ok := interface{}(&JFrogResource{}).(importer); ok {
+		t.Error("expected JFrogResource not to implement import")
+	}
+
+	r := &JFrogResourceWithImportState{JFrogResource{ImportIDFormat: "{key}/{priority}/{enabled}:{project_key?}"}}
+
+	resp := &resource.ImportStateResponse{State: tfsdk.State{Schema: testSchema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "my-repo/10/true:proj"}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var projectKey string
+	resp.State.GetAttribute(ctx, path.Root("project_key"), &projectKey)
+	if projectKey != "proj" {
+		t.Errorf("Incorrect project_key. Expected proj: got: %s", projectKey)
+	}
+	var priority int64
+	resp.State.GetAttribute(ctx, path.Root("priority"), &priority)
+	if priority != 10 {
+		t.Errorf("Incorrect priority. Expected 10: got: %d", priority)
+	}
+	var enabled bool
+	resp.State.GetAttribute(ctx, path.Root("enabled"), &enabled)
+	if !enabled {
+		t.Errorf("Incorrect enabled. Expected true: got: %t", enabled)
+	}
+
+	for _, id := range []string{":", "my-repo/high/true", "my-repo/1.5/true", "my-repo/10/yes"} {
+		resp = &resource.ImportStateResponse{State: tfsdk.State{Schema: testSchema, Raw: tftypes.NewValue(objectType, nil)}}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: id}, resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("expected error for %s, got no error", id)
+		}
+	}
+}
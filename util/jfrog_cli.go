@@ -0,0 +1,153 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/terraform-provider-shared/client"
+)
+
+// JFrogCLIDefaultServerID selects the server marked as default in the JFrog CLI configuration (`jf c use`),
+// unless a server has this ID.
+const JFrogCLIDefaultServerID = "default"
+
+const jfrogCLIConfigFile = "jfrog-cli.conf.v6"
+
+// JFrogCLIServer is a server from the JFrog CLI configuration, as added with `jf c add`
+type JFrogCLIServer struct {
+	ServerID       string `json:"serverId"`
+	URL            string `json:"url"`
+	ArtifactoryURL string `json:"artifactoryUrl"`
+	AccessToken    string `json:"accessToken"`
+	IsDefault      bool   `json:"isDefault"`
+}
+
+// PlatformURL returns the server platform URL, derived from the Artifactory URL for servers added without one
+func (s JFrogCLIServer) PlatformURL() string {
+	if s.URL != "" {
+		return s.URL
+	}
+	return strings.TrimSuffix(strings.TrimRight(s.ArtifactoryURL, "/"), "/artifactory")
+}
+
+// MatchesURL returns true when rawURL is the server platform URL, ignoring the case of the scheme and host and a
+// trailing slash, so the server access token is only sent to the server it belongs to
+func (s JFrogCLIServer) MatchesURL(rawURL string) bool {
+	normalize := func(rawURL string) (string, bool) {
+		u, err := url.Parse(rawURL)
+		if err != nil || u.Host == "" {
+			return "", false
+		}
+		return strings.ToLower(u.Scheme) + "://" + strings.ToLower(u.Host) + strings.TrimRight(u.Path, "/"), true
+	}
+
+	platformURL, ok := normalize(s.PlatformURL())
+	if !ok {
+		return false
+	}
+
+	other, ok := normalize(rawURL)
+	return ok && other == platformURL
+}
+
+type jfrogCLIConfig struct {
+	Servers []JFrogCLIServer `json:"servers"`
+	Enc     bool             `json:"enc"`
+}
+
+// JFrogCLIConfigPath returns the path of the JFrog CLI configuration file, in JFROG_CLI_HOME_DIR or ~/.jfrog
+func JFrogCLIConfigPath() (string, error) {
+	homeDir := os.Getenv("JFROG_CLI_HOME_DIR")
+	if homeDir == "" {
+		userHomeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to find home directory: %w", err)
+		}
+		homeDir = filepath.Join(userHomeDir, ".jfrog")
+	}
+
+	return filepath.Join(homeDir, jfrogCLIConfigFile), nil
+}
+
+// LoadJFrogCLIServer reads the server with serverID from the JFrog CLI configuration file. Use
+// JFrogCLIDefaultServerID for the default server.
+func LoadJFrogCLIServer(serverID string) (*JFrogCLIServer, error) {
+	configPath, err := JFrogCLIConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JFrog CLI configuration: %w. Run `jf c add` to add a server", err)
+	}
+
+	var config jfrogCLIConfig
+	if err := json.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse JFrog CLI configuration %s: %w", configPath, err)
+	}
+
+	if config.Enc {
+		return nil, fmt.Errorf("JFrog CLI configuration %s is encrypted with JFROG_CLI_ENCRYPTION_KEY, which is not supported. Set the access token with the access_token attribute or JFROG_ACCESS_TOKEN environment variable instead", configPath)
+	}
+
+	for _, server := range config.Servers {
+		if server.ServerID == serverID {
+			return &server, nil
+		}
+	}
+
+	if serverID == JFrogCLIDefaultServerID {
+		for _, server := range config.Servers {
+			if server.IsDefault {
+				return &server, nil
+			}
+		}
+		return nil, fmt.Errorf("no default server in JFrog CLI configuration %s. Run `jf c use` to set one", configPath)
+	}
+
+	serverIDs := make([]string, len(config.Servers))
+	for i, server := range config.Servers {
+		serverIDs[i] = server.ServerID
+	}
+
+	return nil, fmt.Errorf("server ID '%s' not found in JFrog CLI configuration %s. Available server IDs: %s", serverID, configPath, strings.Join(serverIDs, ", "))
+}
+
+// JFrogCLITokenSource is a client.TokenSource for the access token of a JFrog CLI server. The configuration is
+// read again on refresh, so tokens refreshed by the JFrog CLI are picked up.
+type JFrogCLITokenSource struct {
+	ServerID string
+}
+
+func (s JFrogCLITokenSource) Token(_ context.Context) (*client.Token, error) {
+	server, err := LoadJFrogCLIServer(s.ServerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if server.AccessToken == "" {
+		return nil, fmt.Errorf("server ID '%s' in JFrog CLI configuration has no access token. Run `jf c add` with an access token", server.ServerID)
+	}
+
+	return &client.Token{AccessToken: server.AccessToken}, nil
+}
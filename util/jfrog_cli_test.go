@@ -0,0 +1,152 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeJFrogCLIConfig(t *testing.T, content string) {
+	homeDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(homeDir, "jfrog-cli.conf.v6"), []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write JFrog CLI configuration: %s", err)
+	}
+	t.Setenv("JFROG_CLI_HOME_DIR", homeDir)
+}
+
+func TestLoadJFrogCLIServer(t *testing.T) {
+	writeJFrogCLIConfig(t, `{
+  "servers": [
+    {
+      "url": "https://acme.jfrog.io/",
+      "artifactoryUrl": "https://acme.jfrog.io/artifactory/",
+      "accessToken": "acme-token",
+      "serverId": "acme"
+    },
+    {
+      "artifactoryUrl": "https://legacy.example.com/artifactory/",
+      "accessToken": "legacy-token",
+      "serverId": "legacy",
+      "isDefault": true
+    }
+  ],
+  "version": "6"
+}`)
+
+	testCases := map[string]struct {
+		serverID    string
+		expectedURL string
+	}{
+		"by server ID":   {serverID: "acme", expectedURL: "https://acme.jfrog.io/"},
+		"default server": {serverID: JFrogCLIDefaultServerID, expectedURL: "https://legacy.example.com"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server, err := LoadJFrogCLIServer(testCase.serverID)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if server.PlatformURL() != testCase.expectedURL {
+				t.Errorf("Incorrect URL. Expected %s: got: %s", testCase.expectedURL, server.PlatformURL())
+			}
+		})
+	}
+
+	if _, err := LoadJFrogCLIServer("unknown"); err == nil {
+		t.Error("expected error for unknown server ID, got no error")
+	}
+
+	token, err := JFrogCLITokenSource{ServerID: "acme"}.Token(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if token.AccessToken != "acme-token" {
+		t.Errorf("Incorrect token. Expected acme-token: got: %s", token.AccessToken)
+	}
+}
+
+func TestLoadJFrogCLIServer_errors(t *testing.T) {
+	testCases := map[string]struct {
+		content  string
+		serverID string
+	}{
+		"encrypted": {
+			content:  `{"servers": [{"serverId": "acme", "accessToken": "encrypted"}], "version": "6", "enc": true}`,
+			serverID: "acme",
+		},
+		"no default server": {
+			content:  `{"servers": [{"serverId": "acme", "accessToken": "acme-token"}], "version": "6"}`,
+			serverID: JFrogCLIDefaultServerID,
+		},
+		"invalid json": {
+			content:  `{"servers": [`,
+			serverID: "acme",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			writeJFrogCLIConfig(t, testCase.content)
+
+			if _, err := LoadJFrogCLIServer(testCase.serverID); err == nil {
+				t.Error("expected error, got no error")
+			}
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Setenv("JFROG_CLI_HOME_DIR", t.TempDir())
+
+		if _, err := LoadJFrogCLIServer("acme"); err == nil {
+			t.Error("expected error, got no error")
+		}
+	})
+}
+
+func TestJFrogCLIServer_MatchesURL(t *testing.T) {
+	acme := JFrogCLIServer{ServerID: "acme", URL: "https://acme.jfrog.io/", AccessToken: "acme-token"}
+	legacy := JFrogCLIServer{ServerID: "legacy", ArtifactoryURL: "https://legacy.example.com/artifactory/", AccessToken: "legacy-token"}
+
+	testCases := map[string]struct {
+		server   JFrogCLIServer
+		url      string
+		expected bool
+	}{
+		"same URL":             {server: acme, url: "https://acme.jfrog.io/", expected: true},
+		"without trailing /":   {server: acme, url: "https://acme.jfrog.io", expected: true},
+		"host case":            {server: acme, url: "https://ACME.jfrog.io/", expected: true},
+		"from Artifactory URL": {server: legacy, url: "https://legacy.example.com/", expected: true},
+		"other host":           {server: acme, url: "https://other.jfrog.io/", expected: false},
+		"other scheme":         {server: acme, url: "http://acme.jfrog.io/", expected: false},
+		"other base path":      {server: acme, url: "https://acme.jfrog.io/jfrog/", expected: false},
+		"Artifactory URL":      {server: legacy, url: "https://legacy.example.com/artifactory/", expected: false},
+		"server without URL":   {server: JFrogCLIServer{ServerID: "empty"}, url: "https://acme.jfrog.io/", expected: false},
+		"invalid provider URL": {server: acme, url: "acme.jfrog.io", expected: false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if matches := testCase.server.MatchesURL(testCase.url); matches != testCase.expected {
+				t.Errorf("Incorrect match of %s. Expected %v: got: %v", testCase.url, testCase.expected, matches)
+			}
+		})
+	}
+}
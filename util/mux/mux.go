@@ -0,0 +1,208 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mux
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// sharedMetadata holds the ProviderMetadata configured by the framework provider for the SDKv2 provider
+type sharedMetadata struct {
+	mu   sync.Mutex
+	meta *util.ProviderMetadata
+}
+
+func (s *sharedMetadata) set(meta util.ProviderMetadata) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.meta = &meta
+}
+
+func (s *sharedMetadata) get() (util.ProviderMetadata, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.meta == nil {
+		return util.ProviderMetadata{}, false
+	}
+	return *s.meta, true
+}
+
+// ProviderServer returns a protocol 5 server muxing the framework and SDKv2 providers, which must have the same
+// provider schema. The framework provider is configured first, e.g. by util.JFrogProvider.Configure, and the SDKv2
+// provider reuses its ProviderMetadata and client instead of configuring again. The SDKv2 ConfigureContextFunc only
+// runs if the framework provider did not produce a ProviderMetadata.
+func ProviderServer(ctx context.Context, frameworkProvider provider.Provider, sdkProvider *schema.Provider) (func() tfprotov5.ProviderServer, error) {
+	shared := &sharedMetadata{}
+
+	configure := sdkProvider.ConfigureContextFunc
+	sdkProvider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		if meta, ok := shared.get(); ok {
+			return meta, nil
+		}
+		if configure == nil {
+			return nil, nil
+		}
+		return configure(ctx, d)
+	}
+
+	servers := []func() tfprotov5.ProviderServer{
+		// the framework provider goes first as the mux configures the servers in order
+		providerserver.NewProtocol5(newSharingProvider(frameworkProvider, shared)),
+		sdkProvider.GRPCProvider,
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, servers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
+
+// Serve serves the muxed framework and SDKv2 providers, see ProviderServer, until Terraform stops the provider.
+// The pending usage features are sent before it returns.
+func Serve(ctx context.Context, address string, frameworkProvider provider.Provider, sdkProvider *schema.Provider, opts ...tf5server.ServeOpt) error {
+	providerServer, err := ProviderServer(ctx, frameworkProvider, sdkProvider)
+	if err != nil {
+		return err
+	}
+
+	err = tf5server.Serve(address, providerServer, opts...)
+	util.ShutdownTelemetry(ctx)
+
+	return err
+}
+
+// ProtoV5ProviderFactories returns the muxed provider for resource.TestCase.ProtoV5ProviderFactories. New providers
+// are created for every test step.
+func ProtoV5ProviderFactories(providerName string, frameworkProvider func() provider.Provider, sdkProvider func() *schema.Provider) map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		providerName: func() (tfprotov5.ProviderServer, error) {
+			providerServer, err := ProviderServer(context.Background(), frameworkProvider(), sdkProvider())
+			if err != nil {
+				return nil, err
+			}
+			return providerServer(), nil
+		},
+	}
+}
+
+// sharingProvider publishes the ProviderMetadata of the framework provider once configured. The optional provider
+// interfaces returning resources, functions or validators are forwarded, and return nothing when the provider
+// doesn't implement them, like a provider without them. MetaSchema is only implemented by
+// sharingProviderWithMetaSchema, as an empty provider_meta schema differs from none.
+type sharingProvider struct {
+	provider.Provider
+	shared *sharedMetadata
+}
+
+func newSharingProvider(p provider.Provider, shared *sharedMetadata) provider.Provider {
+	sharing := &sharingProvider{Provider: p, shared: shared}
+	if _, ok := p.(provider.ProviderWithMetaSchema); ok {
+		return &sharingProviderWithMetaSchema{sharing}
+	}
+	return sharing
+}
+
+var (
+	_ provider.ProviderWithActions            = &sharingProvider{}
+	_ provider.ProviderWithConfigValidators   = &sharingProvider{}
+	_ provider.ProviderWithEphemeralResources = &sharingProvider{}
+	_ provider.ProviderWithFunctions          = &sharingProvider{}
+	_ provider.ProviderWithListResources      = &sharingProvider{}
+	_ provider.ProviderWithValidateConfig     = &sharingProvider{}
+	_ provider.ProviderWithMetaSchema         = &sharingProviderWithMetaSchema{}
+)
+
+func (p *sharingProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	p.Provider.Configure(ctx, req, resp)
+
+	if meta, ok := resp.ResourceData.(util.ProviderMetadata); ok && !resp.Diagnostics.HasError() {
+		p.shared.set(meta)
+	}
+}
+
+func (p *sharingProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return p.Provider.Resources(ctx)
+}
+
+func (p *sharingProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return p.Provider.DataSources(ctx)
+}
+
+func (p *sharingProvider) Actions(ctx context.Context) []func() action.Action {
+	if v, ok := p.Provider.(provider.ProviderWithActions); ok {
+		return v.Actions(ctx)
+	}
+	return nil
+}
+
+func (p *sharingProvider) ConfigValidators(ctx context.Context) []provider.ConfigValidator {
+	if v, ok := p.Provider.(provider.ProviderWithConfigValidators); ok {
+		return v.ConfigValidators(ctx)
+	}
+	return nil
+}
+
+func (p *sharingProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	if v, ok := p.Provider.(provider.ProviderWithEphemeralResources); ok {
+		return v.EphemeralResources(ctx)
+	}
+	return nil
+}
+
+func (p *sharingProvider) Functions(ctx context.Context) []func() function.Function {
+	if v, ok := p.Provider.(provider.ProviderWithFunctions); ok {
+		return v.Functions(ctx)
+	}
+	return nil
+}
+
+func (p *sharingProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	if v, ok := p.Provider.(provider.ProviderWithListResources); ok {
+		return v.ListResources(ctx)
+	}
+	return nil
+}
+
+func (p *sharingProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	if v, ok := p.Provider.(provider.ProviderWithValidateConfig); ok {
+		v.ValidateConfig(ctx, req, resp)
+	}
+}
+
+type sharingProviderWithMetaSchema struct {
+	*sharingProvider
+}
+
+func (p *sharingProviderWithMetaSchema) MetaSchema(ctx context.Context, req provider.MetaSchemaRequest, resp *provider.MetaSchemaResponse) {
+	p.Provider.(provider.ProviderWithMetaSchema).MetaSchema(ctx, req, resp)
+}
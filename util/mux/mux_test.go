@@ -0,0 +1,178 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mux
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+type testFrameworkProvider struct{}
+
+func (p *testFrameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "test"
+}
+
+func (p *testFrameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = fwschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"url": fwschema.StringAttribute{Optional: true},
+		},
+	}
+}
+
+func (p *testFrameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var url types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("url"), &url)...)
+
+	meta := util.ProviderMetadata{ProductId: "framework-" + url.ValueString()}
+	resp.DataSourceData = meta
+	resp.ResourceData = meta
+}
+
+func (p *testFrameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *testFrameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+type testFrameworkProviderWithMetaSchema struct {
+	testFrameworkProvider
+}
+
+func (p *testFrameworkProviderWithMetaSchema) MetaSchema(ctx context.Context, req provider.MetaSchemaRequest, resp *provider.MetaSchemaResponse) {
+	resp.Schema = metaschema.Schema{
+		Attributes: map[string]metaschema.Attribute{
+			"module_name": metaschema.StringAttribute{Optional: true},
+		},
+	}
+}
+
+func testSDKProvider(configured *bool) *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"url": {Type: schema.TypeString, Optional: true},
+		},
+		ConfigureContextFunc: func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			*configured = true
+			return util.ProviderMetadata{ProductId: "sdk"}, nil
+		},
+	}
+}
+
+func TestProviderServer_sharesMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	sdkConfigured := false
+	sdkProvider := testSDKProvider(&sdkConfigured)
+
+	providerServer, err := ProviderServer(ctx, &testFrameworkProvider{}, sdkProvider)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	server := providerServer()
+
+	if _, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	configType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"url": tftypes.String}}
+	config, err := tfprotov5.NewDynamicValue(configType, tftypes.NewValue(configType, map[string]tftypes.Value{
+		"url": tftypes.NewValue(tftypes.String, "https://myinstance.jfrog.io"),
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{Config: &config})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("unexpected error: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	if sdkConfigured {
+		t.Error("expected SDKv2 ConfigureContextFunc not to run")
+	}
+
+	meta, ok := sdkProvider.Meta().(util.ProviderMetadata)
+	if !ok {
+		t.Fatalf("Incorrect SDKv2 meta. Expected util.ProviderMetadata: got: %T", sdkProvider.Meta())
+	}
+	if meta.ProductId != "framework-https://myinstance.jfrog.io" {
+		t.Errorf("Incorrect SDKv2 meta. Expected framework metadata: got: %s", meta.ProductId)
+	}
+}
+
+func TestSharingProvider_metaSchema(t *testing.T) {
+	ctx := context.Background()
+
+	if _, ok := newSharingProvider(&testFrameworkProvider{}, &sharedMetadata{}).(provider.ProviderWithMetaSchema); ok {
+		t.Error("expected provider without meta schema not to implement MetaSchema")
+	}
+
+	server := providerserver.NewProtocol5(newSharingProvider(&testFrameworkProviderWithMetaSchema{}, &sharedMetadata{}))()
+	resp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if resp.ProviderMeta == nil || len(resp.ProviderMeta.Block.Attributes) != 1 || resp.ProviderMeta.Block.Attributes[0].Name != "module_name" {
+		t.Errorf("Incorrect provider meta schema. Expected module_name attribute: got: %v", resp.ProviderMeta)
+	}
+}
+
+func TestProtoV5ProviderFactories(t *testing.T) {
+	factories := ProtoV5ProviderFactories(
+		"test",
+		func() provider.Provider { return &testFrameworkProvider{} },
+		func() *schema.Provider {
+			configured := false
+			return testSDKProvider(&configured)
+		},
+	)
+
+	factory, ok := factories["test"]
+	if !ok {
+		t.Fatal("expected factory for test provider")
+	}
+
+	server, err := factory()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if server == nil {
+		t.Error("expected provider server, got nil")
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-shared/client"
+)
+
+// IDTokenSource provides the OIDC ID token which is exchanged for a JFrog access token by OIDCTokenExchange
+type IDTokenSource interface {
+	IDToken(ctx context.Context) (string, error)
+}
+
+const (
+	IDTokenSourceTFC     = "tfc"
+	IDTokenSourceGitHub  = "github"
+	IDTokenSourceEnv     = "env"
+	IDTokenSourceFile    = "file"
+	IDTokenSourceCommand = "command"
+)
+
+var IDTokenSourceTypes = []string{
+	IDTokenSourceTFC,
+	IDTokenSourceGitHub,
+	IDTokenSourceEnv,
+	IDTokenSourceFile,
+	IDTokenSourceCommand,
+}
+
+// TFCIDTokenSource reads the Terraform Cloud workload identity token from TFC_WORKLOAD_IDENTITY_TOKEN, or
+// TFC_WORKLOAD_IDENTITY_TOKEN_<CredentialTag> when CredentialTag is set.
+type TFCIDTokenSource struct {
+	CredentialTag string
+}
+
+func (s TFCIDTokenSource) IDToken(ctx context.Context) (string, error) {
+	envVars := []string{"TFC_WORKLOAD_IDENTITY_TOKEN"}
+	if s.CredentialTag != "" {
+		envVars = append(
+			envVars,
+			fmt.Sprintf("TFC_WORKLOAD_IDENTITY_TOKEN_%s", s.CredentialTag),
+		)
+	}
+
+	return EnvIDTokenSource{EnvVars: envVars}.IDToken(ctx)
+}
+
+// GitHubActionsIDTokenSource requests an ID token from GitHub Actions, using the ACTIONS_ID_TOKEN_REQUEST_URL
+// and ACTIONS_ID_TOKEN_REQUEST_TOKEN env vars which are set for jobs with `id-token: write` permission.
+type GitHubActionsIDTokenSource struct {
+	// Audience of the ID token, which must match the audience of the JFrog OIDC integration. GitHub uses the
+	// repository owner URL when empty.
+	Audience string
+}
+
+func (s GitHubActionsIDTokenSource) IDToken(ctx context.Context) (string, error) {
+	requestURL := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL")
+	requestToken := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN")
+	if requestURL == "" || requestToken == "" {
+		return "", fmt.Errorf("env var ACTIONS_ID_TOKEN_REQUEST_URL or ACTIONS_ID_TOKEN_REQUEST_TOKEN is not set. Make sure the GitHub Actions job has the `id-token: write` permission")
+	}
+
+	type GitHubIDToken struct {
+		Value string `json:"value"`
+	}
+
+	var result GitHubIDToken
+	req := resty.New().R().
+		SetContext(ctx).
+		SetAuthToken(requestToken).
+		SetResult(&result)
+	if s.Audience != "" {
+		req.SetQueryParam("audience", s.Audience)
+	}
+
+	resp, err := req.Get(requestURL)
+	if err := client.CheckResponse(resp, err); err != nil {
+		return "", fmt.Errorf("failed to get GitHub Actions ID token: %w", err)
+	}
+
+	if result.Value == "" {
+		return "", fmt.Errorf("failed to get GitHub Actions ID token: empty token in response")
+	}
+
+	return result.Value, nil
+}
+
+// EnvIDTokenSource reads the ID token from the first set env var of EnvVars, e.g. a GitLab CI `id_tokens` variable
+type EnvIDTokenSource struct {
+	EnvVars []string
+}
+
+// DefaultIDTokenEnvVars are the GitLab CI env vars used by EnvIDTokenSource when no env var is configured
+var DefaultIDTokenEnvVars = []string{"CI_JOB_JWT_V2", "CI_JOB_JWT"}
+
+func (s EnvIDTokenSource) IDToken(_ context.Context) (string, error) {
+	envVars := s.EnvVars
+	if len(envVars) == 0 {
+		envVars = DefaultIDTokenEnvVars
+	}
+
+	token := CheckEnvVars(envVars, "")
+	if token == "" {
+		return "", fmt.Errorf("env var %s is not set", strings.Join(envVars, " or "))
+	}
+
+	return token, nil
+}
+
+// FileIDTokenSource reads the ID token from a file, e.g. a Kubernetes projected service account token. The file
+// is read for each exchange, so rotated tokens are picked up.
+type FileIDTokenSource struct {
+	Path string
+}
+
+// DefaultIDTokenFile is the Kubernetes service account token, used by FileIDTokenSource when no path is configured
+const DefaultIDTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+func (s FileIDTokenSource) IDToken(_ context.Context) (string, error) {
+	path := s.Path
+	if path == "" {
+		path = DefaultIDTokenFile
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read ID token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("ID token file %s is empty", path)
+	}
+
+	return token, nil
+}
+
+// CommandIDTokenSource runs a command and reads the ID token from its standard output
+type CommandIDTokenSource struct {
+	// Command is the executable followed by its arguments. It is run directly, not through a shell.
+	Command []string
+	Timeout time.Duration
+}
+
+func (s CommandIDTokenSource) IDToken(ctx context.Context) (string, error) {
+	token, err := runCommand(ctx, s.Command, s.Timeout)
+	if err != nil {
+		return "", fmt.Errorf("failed to get ID token: %w", err)
+	}
+
+	return token, nil
+}
+
+// defaultCommandTimeout is used by runCommand when no timeout is set
+const defaultCommandTimeout = 30 * time.Second
+
+// runCommand runs command and returns its trimmed standard output. The output is never logged as it holds
+// credentials.
+func runCommand(ctx context.Context, command []string, timeout time.Duration) (string, error) {
+	if len(command) == 0 || command[0] == "" {
+		return "", fmt.Errorf("command is not set")
+	}
+
+	if timeout <= 0 {
+		timeout = defaultCommandTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return "", fmt.Errorf("command %s timed out after %s", command[0], timeout)
+		}
+		return "", fmt.Errorf("command %s failed: %w: %s", command[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	output := strings.TrimSpace(stdout.String())
+	if output == "" {
+		return "", fmt.Errorf("command %s returned no output", command[0])
+	}
+
+	return output, nil
+}
@@ -0,0 +1,169 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/client"
+)
+
+func TestIDTokenSources(t *testing.T) {
+	t.Setenv("TFC_WORKLOAD_IDENTITY_TOKEN_JFROG", "tfc-token")
+	t.Setenv("CI_JOB_JWT_V2", "gitlab-token")
+	t.Setenv("MY_ID_TOKEN", "custom-token")
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("file-token\n"), 0600); err != nil {
+		t.Fatalf("failed to write token file: %s", err)
+	}
+
+	testCases := map[string]struct {
+		source   IDTokenSource
+		expected string
+	}{
+		"tfc with tag":        {source: TFCIDTokenSource{CredentialTag: "JFROG"}, expected: "tfc-token"},
+		"env default":         {source: EnvIDTokenSource{}, expected: "gitlab-token"},
+		"env custom":          {source: EnvIDTokenSource{EnvVars: []string{"MY_ID_TOKEN"}}, expected: "custom-token"},
+		"file":                {source: FileIDTokenSource{Path: tokenFile}, expected: "file-token"},
+		"command":             {source: CommandIDTokenSource{Command: []string{"echo", "command-token"}}, expected: "command-token"},
+		"command with spaces": {source: CommandIDTokenSource{Command: []string{"printf", "  %s\n", "spaced-token"}}, expected: "spaced-token"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			token, err := testCase.source.IDToken(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if token != testCase.expected {
+				t.Errorf("Incorrect token. Expected %s: got: %s", testCase.expected, token)
+			}
+		})
+	}
+}
+
+func TestIDTokenSources_errors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]IDTokenSource{
+		"env not set":     EnvIDTokenSource{EnvVars: []string{"JFROG_TEST_UNSET_ID_TOKEN"}},
+		"missing file":    FileIDTokenSource{Path: filepath.Join(t.TempDir(), "missing")},
+		"no command":      CommandIDTokenSource{},
+		"failing command": CommandIDTokenSource{Command: []string{"false"}},
+		"no output":       CommandIDTokenSource{Command: []string{"true"}},
+		"timeout":         CommandIDTokenSource{Command: []string{"sleep", "5"}, Timeout: 10 * time.Millisecond},
+	}
+
+	for name, source := range testCases {
+		source := source
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := source.IDToken(context.Background()); err == nil {
+				t.Error("expected error, got no error")
+			}
+		})
+	}
+}
+
+func TestOIDCTokenSource_gitHubActions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/github/token":
+			if r.Header.Get("Authorization") != "Bearer request-token" || r.URL.Query().Get("audience") != "jfrog-github" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"value":"github-id-token"}`))
+		case "/access/api/v1/oidc/token":
+			var req OIDCAccessTokenRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.SubjectToken != "github-id-token" || req.ProviderName != "github-oidc" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token":"jfrog-access-token","expires_in":3600}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", server.URL+"/github/token")
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "request-token")
+
+	restyClient, err := client.Build(server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	source := &OIDCTokenSource{
+		Client:        restyClient,
+		ProviderName:  "github-oidc",
+		IDTokenSource: GitHubActionsIDTokenSource{Audience: "jfrog-github"},
+	}
+
+	token, err := source.Token(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if token.AccessToken != "jfrog-access-token" || token.Expiry.IsZero() {
+		t.Errorf("Incorrect token: %+v", token)
+	}
+}
+
+func TestIDTokenSource_settingOfOtherSource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config      JFrogProviderModel
+		expectError bool
+	}{
+		"matching source": {
+			config: JFrogProviderModel{OIDCTokenSource: types.StringValue(IDTokenSourceFile), OIDCTokenFile: types.StringValue("/token")},
+		},
+		"other source": {
+			config:      JFrogProviderModel{OIDCTokenSource: types.StringValue(IDTokenSourceEnv), OIDCTokenFile: types.StringValue("/token")},
+			expectError: true,
+		},
+		"default source": {
+			config:      JFrogProviderModel{OIDCAudience: types.StringValue("jfrog")},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, diags := idTokenSource(context.Background(), testCase.config)
+			if diags.HasError() != testCase.expectError {
+				t.Errorf("Incorrect error. Expected error: %v: got: %v", testCase.expectError, diags)
+			}
+		})
+	}
+}
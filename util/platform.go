@@ -0,0 +1,281 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-shared/client"
+)
+
+type Product string
+
+const (
+	ProductArtifactory  Product = "Artifactory"
+	ProductAccess       Product = "Access"
+	ProductXray         Product = "Xray"
+	ProductDistribution Product = "Distribution"
+	ProductCatalog      Product = "Catalog"
+)
+
+// Products lists the platform products, in discovery order
+var Products = []Product{ProductArtifactory, ProductAccess, ProductXray, ProductDistribution, ProductCatalog}
+
+const DefaultPlatformDiscoveryTimeout = 30 * time.Second
+
+type versionEndpoint struct {
+	path  string
+	field string
+}
+
+var versionEndpoints = map[Product]versionEndpoint{
+	ProductArtifactory:  {path: "/artifactory/api/system/version", field: "version"},
+	ProductAccess:       {path: "/access/api/v1/system/version", field: "name"},
+	ProductXray:         {path: "/xray/api/v1/system/version", field: "xray_version"},
+	ProductDistribution: {path: "/distribution/api/v1/system/info", field: "version"},
+	ProductCatalog:      {path: "/catalog/api/v1/system/version", field: "version"},
+}
+
+type ProductInfo struct {
+	Installed bool   `json:"installed"`
+	Version   string `json:"version,omitempty"`
+}
+
+// PlatformInfo holds the products installed on a JFrog platform and their versions
+type PlatformInfo struct {
+	Artifactory  ProductInfo `json:"artifactory"`
+	Access       ProductInfo `json:"access"`
+	Xray         ProductInfo `json:"xray"`
+	Distribution ProductInfo `json:"distribution"`
+	Catalog      ProductInfo `json:"catalog"`
+	DiscoveredAt time.Time   `json:"discovered_at"`
+}
+
+// Product returns the information of the given product
+func (p PlatformInfo) Product(product Product) ProductInfo {
+	switch product {
+	case ProductArtifactory:
+		return p.Artifactory
+	case ProductAccess:
+		return p.Access
+	case ProductXray:
+		return p.Xray
+	case ProductDistribution:
+		return p.Distribution
+	case ProductCatalog:
+		return p.Catalog
+	}
+	return ProductInfo{}
+}
+
+func (p *PlatformInfo) setProduct(product Product, info ProductInfo) {
+	switch product {
+	case ProductArtifactory:
+		p.Artifactory = info
+	case ProductAccess:
+		p.Access = info
+	case ProductXray:
+		p.Xray = info
+	case ProductDistribution:
+		p.Distribution = info
+	case ProductCatalog:
+		p.Catalog = info
+	}
+}
+
+func getProductVersion(ctx context.Context, restyClient *resty.Client, product Product) (string, error) {
+	endpoint, ok := versionEndpoints[product]
+	if !ok {
+		return "", fmt.Errorf("unknown product %s", product)
+	}
+
+	result := map[string]interface{}{}
+	resp, err := restyClient.R().
+		SetContext(ctx).
+		SetResult(&result).
+		Get(endpoint.path)
+
+	if err := client.CheckResponse(resp, err); err != nil {
+		return "", fmt.Errorf("failed to get %s version. %w", product, err)
+	}
+
+	version, _ := result[endpoint.field].(string)
+	return version, nil
+}
+
+// DiscoverPlatform fetches the versions of all platform products concurrently. A product which responds with 404
+// is not installed. Errors of the other products are joined, their information is left empty.
+func DiscoverPlatform(ctx context.Context, restyClient *resty.Client, timeout time.Duration) (PlatformInfo, error) {
+	if timeout <= 0 {
+		timeout = DefaultPlatformDiscoveryTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	infos := make([]ProductInfo, len(Products))
+	errs := make([]error, len(Products))
+
+	for i, product := range Products {
+		wg.Add(1)
+		go func(i int, product Product) {
+			defer wg.Done()
+
+			version, err := getProductVersion(ctx, restyClient, product)
+			if err != nil {
+				if !client.IsNotFound(err) {
+					errs[i] = err
+				}
+				return
+			}
+
+			infos[i] = ProductInfo{Installed: true, Version: version}
+		}(i, product)
+	}
+
+	wg.Wait()
+
+	info := PlatformInfo{DiscoveredAt: time.Now()}
+	for i, product := range Products {
+		info.setProduct(product, infos[i])
+	}
+
+	return info, errors.Join(errs...)
+}
+
+// PlatformCache stores discovered PlatformInfo on disk, keyed by platform URL and credential identity, so repeated
+// runs spare a round trip
+type PlatformCache struct {
+	// Dir defaults to the terraform-provider-jfrog directory in the user cache directory
+	Dir string
+	TTL time.Duration
+	// Identity separates the entries of different credentials for the same URL, since the visible products depend
+	// on the token entitlements. See CredentialIdentity.
+	Identity string
+}
+
+// CredentialIdentity returns the cache identity of an access token: the subject of a JWT, so that renewed tokens of
+// the same user share entries, or else a digest of the token
+func CredentialIdentity(accessToken string) string {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) == 3 {
+		if payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "=")); err == nil {
+			var claims struct {
+				Subject string `json:"sub"`
+			}
+			if err := json.Unmarshal(payload, &claims); err == nil && claims.Subject != "" {
+				return "sub:" + claims.Subject
+			}
+		}
+	}
+
+	sum := sha256.Sum256([]byte(accessToken))
+	return "token:" + hex.EncodeToString(sum[:])
+}
+
+func (c PlatformCache) path(url string) (string, error) {
+	dir := c.Dir
+	if dir == "" {
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(cacheDir, "terraform-provider-jfrog")
+	}
+
+	sum := sha256.Sum256([]byte(strings.TrimSuffix(url, "/") + "\x00" + c.Identity))
+	return filepath.Join(dir, fmt.Sprintf("platform-%s.json", hex.EncodeToString(sum[:8]))), nil
+}
+
+// Load returns the cached PlatformInfo of the URL, if present and not older than the TTL
+func (c PlatformCache) Load(url string) (PlatformInfo, bool) {
+	if c.TTL <= 0 {
+		return PlatformInfo{}, false
+	}
+
+	path, err := c.path(url)
+	if err != nil {
+		return PlatformInfo{}, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return PlatformInfo{}, false
+	}
+
+	var info PlatformInfo
+	if err := json.Unmarshal(data, &info); err != nil {
+		log.Printf("[DEBUG] Ignoring invalid platform cache %s: %s", path, err)
+		return PlatformInfo{}, false
+	}
+
+	if time.Since(info.DiscoveredAt) > c.TTL {
+		return PlatformInfo{}, false
+	}
+
+	return info, true
+}
+
+// Store writes the PlatformInfo of the URL to the cache
+func (c PlatformCache) Store(url string, info PlatformInfo) error {
+	if c.TTL <= 0 {
+		return nil
+	}
+
+	path, err := c.path(url)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so concurrent runs never read a partial file
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
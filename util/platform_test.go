@@ -0,0 +1,176 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jfrog/terraform-provider-shared/client"
+)
+
+func TestDiscoverPlatform(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/artifactory/api/system/version":
+			w.Write([]byte(`{"version":"7.90.1"}`))
+		case "/access/api/v1/system/version":
+			w.Write([]byte(`{"name":"7.120.0"}`))
+		case "/xray/api/v1/system/version":
+			w.Write([]byte(`{"xray_version":"3.100.0"}`))
+		case "/catalog/api/v1/system/version":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	restyClient, err := client.Build(server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	platform, err := DiscoverPlatform(context.Background(), restyClient, 0)
+	if err == nil {
+		t.Error("expected Catalog error, got no error")
+	}
+
+	expected := map[Product]ProductInfo{
+		ProductArtifactory:  {Installed: true, Version: "7.90.1"},
+		ProductAccess:       {Installed: true, Version: "7.120.0"},
+		ProductXray:         {Installed: true, Version: "3.100.0"},
+		ProductDistribution: {},
+		ProductCatalog:      {},
+	}
+
+	for product, info := range expected {
+		if platform.Product(product) != info {
+			t.Errorf("Incorrect %s. Expected %+v: got: %+v", product, info, platform.Product(product))
+		}
+	}
+}
+
+func TestDiscoverPlatform_timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	restyClient, err := client.Build(server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	start := time.Now()
+	if _, err := DiscoverPlatform(context.Background(), restyClient, 50*time.Millisecond); err == nil {
+		t.Error("expected error, got no error")
+	}
+
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Incorrect duration. Expected about 50ms: got: %s", elapsed)
+	}
+}
+
+func TestPlatformCache(t *testing.T) {
+	t.Parallel()
+
+	platform := PlatformInfo{
+		Artifactory:  ProductInfo{Installed: true, Version: "7.90.1"},
+		DiscoveredAt: time.Now(),
+	}
+
+	cache := PlatformCache{Dir: t.TempDir(), TTL: time.Minute}
+	if err := cache.Store("https://myinstance.jfrog.io/", platform); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cached, ok := cache.Load("https://myinstance.jfrog.io")
+	if !ok {
+		t.Fatal("expected cached platform, got none")
+	}
+	if cached.Artifactory != platform.Artifactory {
+		t.Errorf("Incorrect Artifactory. Expected %+v: got: %+v", platform.Artifactory, cached.Artifactory)
+	}
+
+	if _, ok := cache.Load("https://other.jfrog.io"); ok {
+		t.Error("expected no cached platform for other URL")
+	}
+
+	otherIdentity := PlatformCache{Dir: cache.Dir, TTL: time.Minute, Identity: "sub:other"}
+	if _, ok := otherIdentity.Load("https://myinstance.jfrog.io"); ok {
+		t.Error("expected no cached platform for other identity")
+	}
+
+	expired := PlatformCache{Dir: cache.Dir, TTL: time.Nanosecond}
+	if _, ok := expired.Load("https://myinstance.jfrog.io"); ok {
+		t.Error("expected expired cached platform to be ignored")
+	}
+
+	disabled := PlatformCache{Dir: cache.Dir}
+	if _, ok := disabled.Load("https://myinstance.jfrog.io"); ok {
+		t.Error("expected disabled cache to be ignored")
+	}
+}
+
+func TestCredentialIdentity(t *testing.T) {
+	t.Parallel()
+
+	claims := func(payload string) string {
+		return "header." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+	}
+
+	testCases := map[string]struct {
+		token    string
+		expected string
+	}{
+		"JWT subject": {
+			token:    claims(`{"sub":"jfrt@01abc/users/admin","exp":1}`),
+			expected: "sub:jfrt@01abc/users/admin",
+		},
+		"renewed JWT": {
+			token:    claims(`{"sub":"jfrt@01abc/users/admin","exp":2}`),
+			expected: "sub:jfrt@01abc/users/admin",
+		},
+		"JWT without subject": {
+			token:    claims(`{"exp":1}`),
+			expected: "token:",
+		},
+		"opaque token": {
+			token:    "cmVmdGtuOjAxOjE3",
+			expected: "token:",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			identity := CredentialIdentity(testCase.token)
+			if !strings.HasPrefix(identity, testCase.expected) || (testCase.expected == "token:" && strings.Contains(identity, testCase.token)) {
+				t.Errorf("Incorrect identity. Expected %s: got: %s", testCase.expected, identity)
+			}
+		})
+	}
+}
@@ -17,15 +17,26 @@ package util
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strconv"
+	"time"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-shared/client"
 	validator_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
 )
 
 type JFrogProvider struct {
@@ -41,13 +52,241 @@ type ProviderMetadata struct {
 	ArtifactoryVersion string
 	AccessVersion      string
 	XrayVersion        string
+	Platform           PlatformInfo
+	// CatalogHealthChecker caches the Catalog health of this provider instance
+	CatalogHealthChecker *CatalogHealthChecker
+	// Telemetry batches the usage reports of this provider instance
+	Telemetry *Telemetry
 }
 
 type JFrogProviderModel struct {
-	Url                  types.String `tfsdk:"url"`
-	AccessToken          types.String `tfsdk:"access_token"`
-	OIDCProviderName     types.String `tfsdk:"oidc_provider_name"`
-	TFCCredentialTagName types.String `tfsdk:"tfc_credential_tag_name"`
+	Url                        types.String  `tfsdk:"url"`
+	AccessToken                types.String  `tfsdk:"access_token"`
+	OIDCProviderName           types.String  `tfsdk:"oidc_provider_name"`
+	TFCCredentialTagName       types.String  `tfsdk:"tfc_credential_tag_name"`
+	OIDCTokenSource            types.String  `tfsdk:"oidc_token_source"`
+	OIDCAudience               types.String  `tfsdk:"oidc_audience"`
+	OIDCTokenEnvVar            types.String  `tfsdk:"oidc_token_env_var"`
+	OIDCTokenFile              types.String  `tfsdk:"oidc_token_file"`
+	OIDCTokenCommand           types.List    `tfsdk:"oidc_token_command"`
+	RetryMaxAttempts           types.Int64   `tfsdk:"retry_max_attempts"`
+	RetryMinWait               types.String  `tfsdk:"retry_min_wait"`
+	RetryMaxWait               types.String  `tfsdk:"retry_max_wait"`
+	RetryStatusCodes           types.Set     `tfsdk:"retry_status_codes"`
+	RetryNonIdempotentRequests types.Bool    `tfsdk:"retry_non_idempotent_requests"`
+	MaxRequestsPerSecond       types.Float64 `tfsdk:"max_requests_per_second"`
+	MaxConcurrentRequests      types.Int64   `tfsdk:"max_concurrent_requests"`
+	CACert                     types.String  `tfsdk:"ca_cert"`
+	ClientCert                 types.String  `tfsdk:"client_cert"`
+	ClientKey                  types.String  `tfsdk:"client_key"`
+	TLSMinVersion              types.String  `tfsdk:"tls_min_version"`
+	InsecureSkipVerify         types.Bool    `tfsdk:"insecure_skip_verify"`
+	ProxyURL                   types.String  `tfsdk:"proxy_url"`
+	ProxyUsername              types.String  `tfsdk:"proxy_username"`
+	ProxyPassword              types.String  `tfsdk:"proxy_password"`
+	NoProxy                    types.Set     `tfsdk:"no_proxy"`
+	JFrogCLIServerID           types.String  `tfsdk:"jfrog_cli_server_id"`
+	AccessTokenCommand         types.List    `tfsdk:"access_token_command"`
+	AccessTokenCommandTimeout  types.String  `tfsdk:"access_token_command_timeout"`
+	PlatformCacheTTL           types.String  `tfsdk:"platform_cache_ttl"`
+	DisableUsageReporting      types.Bool    `tfsdk:"disable_usage_reporting"`
+	AuditLogPath               types.String  `tfsdk:"audit_log_path"`
+	LogBodyLimit               types.Int64   `tfsdk:"log_body_limit"`
+}
+
+// clientOptions converts the client related provider configuration, with environment variables as fallback,
+// into options for client.Build
+func clientOptions(ctx context.Context, config JFrogProviderModel) ([]client.Option, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	retryPolicy, ds := retryPolicy(ctx, config)
+	diags.Append(ds...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	opts := []client.Option{
+		client.WithRetryPolicy(retryPolicy),
+	}
+
+	maxRequestsPerSecond := CheckEnvVars([]string{"JFROG_MAX_REQUESTS_PER_SECOND"}, "")
+	if !config.MaxRequestsPerSecond.IsNull() {
+		maxRequestsPerSecond = strconv.FormatFloat(config.MaxRequestsPerSecond.ValueFloat64(), 'f', -1, 64)
+	}
+	if maxRequestsPerSecond != "" {
+		v, err := strconv.ParseFloat(maxRequestsPerSecond, 64)
+		if err != nil || v < 0 {
+			diags.AddError(
+				"Invalid max requests per second",
+				fmt.Sprintf("Value '%s' (from provider configuration or JFROG_MAX_REQUESTS_PER_SECOND environment variable) must be a non-negative number.", maxRequestsPerSecond),
+			)
+		} else {
+			opts = append(opts, client.WithRateLimit(v))
+		}
+	}
+
+	maxConcurrentRequests := CheckEnvVars([]string{"JFROG_MAX_CONCURRENT_REQUESTS"}, "")
+	if !config.MaxConcurrentRequests.IsNull() {
+		maxConcurrentRequests = strconv.FormatInt(config.MaxConcurrentRequests.ValueInt64(), 10)
+	}
+	if maxConcurrentRequests != "" {
+		v, err := strconv.Atoi(maxConcurrentRequests)
+		if err != nil || v < 0 {
+			diags.AddError(
+				"Invalid max concurrent requests",
+				fmt.Sprintf("Value '%s' (from provider configuration or JFROG_MAX_CONCURRENT_REQUESTS environment variable) must be a non-negative integer.", maxConcurrentRequests),
+			)
+		} else {
+			opts = append(opts, client.WithMaxConcurrentRequests(v))
+		}
+	}
+
+	tlsConfig := client.TLSConfig{
+		CACert:             CheckEnvVars([]string{"JFROG_CA_CERT"}, ""),
+		ClientCert:         CheckEnvVars([]string{"JFROG_CLIENT_CERT"}, ""),
+		ClientKey:          CheckEnvVars([]string{"JFROG_CLIENT_KEY"}, ""),
+		MinVersion:         CheckEnvVars([]string{"JFROG_TLS_MIN_VERSION"}, ""),
+		InsecureSkipVerify: GetBoolEnvVar([]string{"JFROG_INSECURE_SKIP_VERIFY"}, false),
+	}
+	if config.CACert.ValueString() != "" {
+		tlsConfig.CACert = config.CACert.ValueString()
+	}
+	if config.ClientCert.ValueString() != "" {
+		tlsConfig.ClientCert = config.ClientCert.ValueString()
+	}
+	if config.ClientKey.ValueString() != "" {
+		tlsConfig.ClientKey = config.ClientKey.ValueString()
+	}
+	if config.TLSMinVersion.ValueString() != "" {
+		tlsConfig.MinVersion = config.TLSMinVersion.ValueString()
+	}
+	if !config.InsecureSkipVerify.IsNull() {
+		tlsConfig.InsecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	}
+
+	if tlsConfig.InsecureSkipVerify {
+		diags.AddWarning(
+			"TLS certificate verification is disabled",
+			"insecure_skip_verify is enabled (from provider configuration or JFROG_INSECURE_SKIP_VERIFY environment variable). "+
+				"The provider will not verify the JFrog Platform server certificate, which exposes the access token and all API traffic to man-in-the-middle attacks. "+
+				"Only use this for testing, and use ca_cert to trust a private CA instead.",
+		)
+	}
+
+	if tlsConfig != (client.TLSConfig{}) {
+		opts = append(opts, client.WithTLSConfig(tlsConfig))
+	}
+
+	if config.ProxyURL.ValueString() != "" {
+		proxyConfig := client.ProxyConfig{
+			URL:      config.ProxyURL.ValueString(),
+			Username: config.ProxyUsername.ValueString(),
+			Password: config.ProxyPassword.ValueString(),
+		}
+		if !config.NoProxy.IsNull() && !config.NoProxy.IsUnknown() {
+			diags.Append(config.NoProxy.ElementsAs(ctx, &proxyConfig.NoProxy, false)...)
+		}
+		opts = append(opts, client.WithProxy(proxyConfig))
+	}
+
+	logBodyLimit := CheckEnvVars([]string{"JFROG_LOG_BODY_LIMIT"}, "")
+	if !config.LogBodyLimit.IsNull() {
+		logBodyLimit = strconv.FormatInt(config.LogBodyLimit.ValueInt64(), 10)
+	}
+	if logBodyLimit != "" {
+		v, err := strconv.Atoi(logBodyLimit)
+		if err != nil || v < 0 {
+			diags.AddError(
+				"Invalid log body limit",
+				fmt.Sprintf("Value '%s' (from provider configuration or JFROG_LOG_BODY_LIMIT environment variable) must be a non-negative integer.", logBodyLimit),
+			)
+		} else {
+			opts = append(opts, client.WithLogBodyLimit(v))
+		}
+	}
+
+	auditLogPath := CheckEnvVars([]string{"JFROG_AUDIT_LOG_PATH"}, "")
+	if config.AuditLogPath.ValueString() != "" {
+		auditLogPath = config.AuditLogPath.ValueString()
+	}
+	if auditLogPath != "" {
+		opts = append(opts, client.WithAuditLog(auditLogPath))
+	}
+
+	return opts, diags
+}
+
+func retryPolicy(ctx context.Context, config JFrogProviderModel) (client.RetryPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	policy := client.DefaultRetryPolicy()
+
+	maxAttempts := CheckEnvVars([]string{"JFROG_RETRY_MAX_ATTEMPTS"}, "")
+	if maxAttempts != "" {
+		v, err := strconv.Atoi(maxAttempts)
+		if err != nil {
+			diags.AddError(
+				"Invalid JFROG_RETRY_MAX_ATTEMPTS environment variable",
+				fmt.Sprintf("Value '%s' is not a number.", maxAttempts),
+			)
+		} else {
+			policy.MaxAttempts = v
+		}
+	}
+	if !config.RetryMaxAttempts.IsNull() {
+		policy.MaxAttempts = int(config.RetryMaxAttempts.ValueInt64())
+	}
+
+	durations := []struct {
+		attribute types.String
+		envVar    string
+		value     *time.Duration
+	}{
+		{config.RetryMinWait, "JFROG_RETRY_MIN_WAIT", &policy.MinWait},
+		{config.RetryMaxWait, "JFROG_RETRY_MAX_WAIT", &policy.MaxWait},
+	}
+	for _, d := range durations {
+		value := CheckEnvVars([]string{d.envVar}, "")
+		if d.attribute.ValueString() != "" {
+			value = d.attribute.ValueString()
+		}
+		if value == "" {
+			continue
+		}
+
+		wait, err := time.ParseDuration(value)
+		if err != nil {
+			diags.AddError(
+				"Invalid retry wait time",
+				fmt.Sprintf("Value '%s' (from provider configuration or %s environment variable) is not a valid duration: %s", value, d.envVar, err),
+			)
+			continue
+		}
+		*d.value = wait
+	}
+
+	if !config.RetryStatusCodes.IsNull() && !config.RetryStatusCodes.IsUnknown() {
+		var statusCodes []int64
+		diags.Append(config.RetryStatusCodes.ElementsAs(ctx, &statusCodes, false)...)
+		policy.RetryableStatusCodes = lo.Map(statusCodes, func(code int64, _ int) int { return int(code) })
+	}
+
+	if !config.RetryNonIdempotentRequests.IsNull() {
+		policy.RetryNonIdempotent = config.RetryNonIdempotentRequests.ValueBool()
+	}
+
+	if diags.HasError() {
+		return policy, diags
+	}
+
+	if err := policy.Validate(); err != nil {
+		diags.AddError(
+			"Invalid retry configuration",
+			err.Error(),
+		)
+	}
+
+	return policy, diags
 }
 
 func (p *JFrogProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -67,15 +306,45 @@ func (p *JFrogProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		url = config.Url.ValueString()
 	}
 
+	// JFrog CLI configuration is only used for url and access token not found in
+	// provider configuration or environment variables
+	jfrogCLIServerID := CheckEnvVars([]string{"JFROG_CLI_SERVER_ID"}, "")
+	if config.JFrogCLIServerID.ValueString() != "" {
+		jfrogCLIServerID = config.JFrogCLIServerID.ValueString()
+	}
+
+	var jfrogCLIServer *JFrogCLIServer
+	if jfrogCLIServerID != "" {
+		server, err := LoadJFrogCLIServer(jfrogCLIServerID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading JFrog CLI configuration",
+				err.Error(),
+			)
+			return
+		}
+		jfrogCLIServer = server
+
+		if url == "" {
+			url = jfrogCLIServer.PlatformURL()
+		}
+	}
+
 	if url == "" {
 		resp.Diagnostics.AddError(
 			"Missing URL Configuration",
-			"While configuring the provider, the url was not found in the JFROG_URL environment variable or provider configuration block url attribute.",
+			"While configuring the provider, the url was not found in the JFROG_URL environment variable, provider configuration block url attribute or JFrog CLI configuration.",
 		)
 		return
 	}
 
-	restyClient, err := client.Build(url, p.ProductID)
+	clientOpts, diags := clientOptions(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	restyClient, err := client.Build(url, p.ProductID, clientOpts...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Resty client",
@@ -84,95 +353,122 @@ func (p *JFrogProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
+	var tokenSource client.TokenSource
+	if jfrogCLIServer != nil && jfrogCLIServer.AccessToken != "" {
+		if jfrogCLIServer.MatchesURL(url) {
+			tokenSource = JFrogCLITokenSource{ServerID: jfrogCLIServerID}
+		} else if accessToken == "" {
+			tflog.Warn(ctx, "Not using the JFrog CLI access token, the url is not the JFrog CLI server URL", map[string]interface{}{
+				"jfrog_cli_server_id":  jfrogCLIServerID,
+				"jfrog_cli_server_url": jfrogCLIServer.PlatformURL(),
+				"url":                  url,
+			})
+		}
+	}
+
+	if accessToken != "" {
+		tokenSource = client.StaticTokenSource(accessToken)
+	}
+
+	// use token from OIDC provider, which should take precedence over
+	// environment variable data, if found.
 	oidcProviderName := config.OIDCProviderName.ValueString()
 	if oidcProviderName != "" {
-		oidcAccessToken, err := OIDCTokenExchange(ctx, restyClient, oidcProviderName, config.TFCCredentialTagName.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Failed OIDC ID token exchange",
-				err.Error(),
-			)
+		idTokenSource, diags := idTokenSource(ctx, config)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
 
-		// use token from OIDC provider, which should take precedence over
-		// environment variable data, if found.
-		if oidcAccessToken != "" {
-			accessToken = oidcAccessToken
+		tokenSource = &OIDCTokenSource{
+			Client:        restyClient,
+			ProviderName:  oidcProviderName,
+			IDTokenSource: idTokenSource,
 		}
 	}
 
-	// use token from configuration, which should take precedence over
+	// use token from credential helper command, which should take precedence over
 	// environment variable data or OIDC provider, if found.
-	if config.AccessToken.ValueString() != "" {
-		accessToken = config.AccessToken.ValueString()
-	}
+	if !config.AccessTokenCommand.IsNull() && !config.AccessTokenCommand.IsUnknown() {
+		var command []string
+		resp.Diagnostics.Append(config.AccessTokenCommand.ElementsAs(ctx, &command, false)...)
 
-	if accessToken == "" {
-		resp.Diagnostics.AddWarning(
-			"Missing JFrog Access Token",
-			"Access Token was not found in the JFROG_ACCESS_TOKEN environment variable, provider configuration block access_token attribute, or Terraform Cloud TFC_WORKLOAD_IDENTITY_TOKEN environment variable. Platform functionality will be affected.",
-		)
-	}
+		var timeout time.Duration
+		if config.AccessTokenCommandTimeout.ValueString() != "" {
+			timeout, err = time.ParseDuration(config.AccessTokenCommandTimeout.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("access_token_command_timeout"),
+					"Invalid access token command timeout",
+					err.Error(),
+				)
+			}
+		}
 
-	artifactoryVersion := ""
-	if len(accessToken) > 0 {
-		_, err = client.AddAuth(restyClient, "", accessToken)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error adding Auth to Resty client",
-				err.Error(),
-			)
+		if resp.Diagnostics.HasError() {
 			return
 		}
 
-		version, err := GetArtifactoryVersion(restyClient)
-		if err != nil {
-			resp.Diagnostics.AddWarning(
-				"Error getting Artifactory version",
-				fmt.Sprintf("Provider functionality might be affected by the absence of Artifactory version. %v", err),
-			)
+		tokenSource = CommandTokenSource{
+			Command: command,
+			Timeout: timeout,
 		}
+	}
 
-		artifactoryVersion = version
-
-		featureUsage := fmt.Sprintf("Terraform/%s", req.TerraformVersion)
-		go SendUsage(ctx, restyClient.R(), p.ProductID, featureUsage)
+	// use token from configuration, which should take precedence over
+	// environment variable data, credential helper command or OIDC provider, if found.
+	if config.AccessToken.ValueString() != "" {
+		tokenSource = client.StaticTokenSource(config.AccessToken.ValueString())
 	}
 
-	accessVersion := ""
-	if len(accessToken) > 0 {
-		_, err = client.AddAuth(restyClient, "", accessToken)
+	if tokenSource == nil {
+		resp.Diagnostics.AddWarning(
+			"Missing JFrog Access Token",
+			"Access Token was not found in the JFROG_ACCESS_TOKEN environment variable, provider configuration block access_token attribute, Terraform Cloud TFC_WORKLOAD_IDENTITY_TOKEN environment variable, or JFrog CLI configuration. Platform functionality will be affected.",
+		)
+	} else {
+		// tokens are refreshed before they expire or after a 401 response, when the token source supports it
+		err = client.AddTokenSource(ctx, restyClient, tokenSource)
 		if err != nil {
+			summary := "Error adding Auth to Resty client"
+			if _, ok := tokenSource.(*OIDCTokenSource); ok {
+				summary = "Failed OIDC ID token exchange"
+			}
 			resp.Diagnostics.AddError(
-				"Error adding Auth to Resty client",
+				summary,
 				err.Error(),
 			)
 			return
 		}
+	}
 
-		version, err := GetAccessVersion(restyClient)
-		if err != nil {
-			resp.Diagnostics.AddWarning(
-				"Error getting Access version",
-				fmt.Sprintf("Provider functionality might be affected by the absence of Access version. %v", err),
-			)
+	var platform PlatformInfo
+	if tokenSource != nil {
+		var diags diag.Diagnostics
+		platform, diags = discoverPlatform(ctx, restyClient, url, config)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
+	}
 
-		accessVersion = version
-
-		featureUsage := fmt.Sprintf("Terraform/%s", req.TerraformVersion)
-		go SendUsage(ctx, restyClient.R(), p.ProductID, featureUsage)
+	disableUsageReporting := UsageReportingDisabled()
+	if !config.DisableUsageReporting.IsNull() {
+		disableUsageReporting = config.DisableUsageReporting.ValueBool()
 	}
 
-	featureUsage := fmt.Sprintf("Terraform/%s", req.TerraformVersion)
-	go SendUsage(ctx, restyClient.R(), p.ProductID, featureUsage)
+	telemetry := NewTelemetry(restyClient, p.ProductID, disableUsageReporting)
+	telemetry.Record(ctx, fmt.Sprintf("Terraform/%s", req.TerraformVersion))
 
 	meta := ProviderMetadata{
-		Client:             restyClient,
-		ArtifactoryVersion: artifactoryVersion,
-		AccessVersion:      accessVersion,
-		ProductId:          p.ProductID,
+		Client:               restyClient,
+		ArtifactoryVersion:   platform.Artifactory.Version,
+		AccessVersion:        platform.Access.Version,
+		XrayVersion:          platform.Xray.Version,
+		Platform:             platform,
+		ProductId:            p.ProductID,
+		CatalogHealthChecker: &CatalogHealthChecker{},
+		Telemetry:            telemetry,
 	}
 
 	p.Meta = meta
@@ -181,6 +477,113 @@ func (p *JFrogProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	resp.ResourceData = meta
 }
 
+// discoverPlatform returns the platform products and versions from the cache, if enabled, or discovers and caches them
+func discoverPlatform(ctx context.Context, restyClient *resty.Client, url string, config JFrogProviderModel) (PlatformInfo, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	cacheTTL := CheckEnvVars([]string{"JFROG_PLATFORM_CACHE_TTL"}, "0s")
+	if config.PlatformCacheTTL.ValueString() != "" {
+		cacheTTL = config.PlatformCacheTTL.ValueString()
+	}
+
+	ttl, err := time.ParseDuration(cacheTTL)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("platform_cache_ttl"),
+			"Invalid platform cache TTL",
+			err.Error(),
+		)
+		return PlatformInfo{}, diags
+	}
+
+	cache := PlatformCache{TTL: ttl}
+	if ttl > 0 {
+		// the token source is not called again, as that could rotate the token or rerun a credential helper
+		if token := client.CurrentToken(restyClient); token != nil {
+			cache.Identity = CredentialIdentity(token.AccessToken)
+		} else {
+			cache.TTL = 0
+		}
+	}
+
+	if platform, ok := cache.Load(url); ok {
+		tflog.Debug(ctx, "Using cached platform versions", map[string]interface{}{"discovered_at": platform.DiscoveredAt})
+		return platform, diags
+	}
+
+	platform, err := DiscoverPlatform(ctx, restyClient, DefaultPlatformDiscoveryTimeout)
+	if err != nil {
+		diags.AddWarning(
+			"Error getting platform versions",
+			fmt.Sprintf("Provider functionality might be affected by the absence of product versions. %v", err),
+		)
+		return platform, diags
+	}
+
+	if err := cache.Store(url, platform); err != nil {
+		tflog.Debug(ctx, "Failed to cache platform versions", map[string]interface{}{"error": err.Error()})
+	}
+
+	return platform, diags
+}
+
+// idTokenSource returns the OIDC ID token source selected by the oidc_token_source attribute
+func idTokenSource(ctx context.Context, config JFrogProviderModel) (IDTokenSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	source := config.OIDCTokenSource.ValueString()
+
+	// settings of other sources would be silently ignored
+	sourceSettings := []struct {
+		name   string
+		source string
+		isSet  bool
+	}{
+		{"oidc_audience", IDTokenSourceGitHub, config.OIDCAudience.ValueString() != ""},
+		{"oidc_token_env_var", IDTokenSourceEnv, config.OIDCTokenEnvVar.ValueString() != ""},
+		{"oidc_token_file", IDTokenSourceFile, config.OIDCTokenFile.ValueString() != ""},
+		{"oidc_token_command", IDTokenSourceCommand, !config.OIDCTokenCommand.IsNull() && !config.OIDCTokenCommand.IsUnknown()},
+	}
+	for _, setting := range sourceSettings {
+		if setting.isSet && setting.source != source {
+			diags.AddAttributeError(
+				path.Root(setting.name),
+				"Invalid OIDC token source setting",
+				fmt.Sprintf("%s only applies when oidc_token_source is `%s`, got `%s`.", setting.name, setting.source, source),
+			)
+		}
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	switch source {
+	case IDTokenSourceGitHub:
+		return GitHubActionsIDTokenSource{Audience: config.OIDCAudience.ValueString()}, diags
+	case IDTokenSourceEnv:
+		var envVars []string
+		if config.OIDCTokenEnvVar.ValueString() != "" {
+			envVars = []string{config.OIDCTokenEnvVar.ValueString()}
+		}
+		return EnvIDTokenSource{EnvVars: envVars}, diags
+	case IDTokenSourceFile:
+		return FileIDTokenSource{Path: config.OIDCTokenFile.ValueString()}, diags
+	case IDTokenSourceCommand:
+		var command []string
+		diags.Append(config.OIDCTokenCommand.ElementsAs(ctx, &command, false)...)
+		if len(command) == 0 && !diags.HasError() {
+			diags.AddAttributeError(
+				path.Root("oidc_token_command"),
+				"Missing OIDC token command",
+				"oidc_token_command must be set when oidc_token_source is `command`.",
+			)
+		}
+		return CommandIDTokenSource{Command: command}, diags
+	default:
+		return TFCIDTokenSource{CredentialTag: config.TFCCredentialTagName.ValueString()}, diags
+	}
+}
+
 func (p *JFrogProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = p.TypeName
 	resp.Version = p.Version
@@ -194,7 +597,7 @@ func (p *JFrogProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Validators: []validator.String{
 					validator_string.IsURLHttpOrHttps(),
 				},
-				MarkdownDescription: "JFrog Platform URL. This can also be sourced from the `JFROG_URL` environment variable.",
+				MarkdownDescription: "JFrog Platform URL. A base path (e.g. `https://gateway.example.com/jfrog`) is supported when the platform is served behind a reverse proxy. This can also be sourced from the `JFROG_URL` environment variable.",
 			},
 			"access_token": schema.StringAttribute{
 				Optional:  true,
@@ -204,6 +607,45 @@ func (p *JFrogProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				},
 				MarkdownDescription: "This is a access token that can be given to you by your admin under `Platform Configuration -> User Management -> Access Tokens`. This can also be sourced from the `JFROG_ACCESS_TOKEN` environment variable.",
 			},
+			"access_token_command": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+					listvalidator.ConflictsWith(path.MatchRoot("access_token")),
+				},
+				MarkdownDescription: "Credential helper command, followed by its arguments, which prints the access token to standard output, e.g. `[\"op\", \"read\", \"op://vault/jfrog/token\"]`. " +
+					"The output is either the access token, or a JSON object with `access_token` and optional `expires_in` (seconds) fields. " +
+					"The command is run directly, not through a shell, and runs again when the token expires or is rejected. Its output is never logged. " +
+					"Takes precedence over OIDC and the `JFROG_ACCESS_TOKEN` environment variable.",
+			},
+			"access_token_command_timeout": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validator_string.IsDuration(),
+					stringvalidator.AlsoRequires(path.MatchRoot("access_token_command")),
+				},
+				MarkdownDescription: "Timeout of `access_token_command`, e.g. `10s`. Default to `30s`.",
+			},
+			"platform_cache_ttl": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validator_string.IsDuration(),
+				},
+				MarkdownDescription: "How long the discovered product versions of the platform are cached on disk, keyed by URL and token subject, e.g. `5m`. Newly installed or upgraded products are not detected until the cache expires. Default to `0s`, which disables the cache. This can also be sourced from the `JFROG_PLATFORM_CACHE_TTL` environment variable.",
+			},
+			"audit_log_path": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Path of a file to which a JSON line is appended for every mutating request (`POST`, `PUT`, `PATCH` and `DELETE`) with its timestamp, method, path, status, duration, request ID and a SHA-256 digest of the request body with secrets redacted. This can also be sourced from the `JFROG_AUDIT_LOG_PATH` environment variable.",
+			},
+			"disable_usage_reporting": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Disable sending usage data (Terraform version and resource operations) to the platform. Default to `false`. This can also be sourced from the `JFROG_TF_DISABLE_USAGE` environment variable.",
+			},
 			"oidc_provider_name": schema.StringAttribute{
 				Optional: true,
 				Validators: []validator.String{
@@ -218,6 +660,181 @@ func (p *JFrogProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				},
 				Description: "Terraform Cloud Workload Identity Token tag name. Use for generating multiple TFC workload identity tokens. When set, the provider will attempt to use env var with this tag name as suffix. **Note:** this is case sensitive, so if set to `JFROG`, then env var `TFC_WORKLOAD_IDENTITY_TOKEN_JFROG` is used instead of `TFC_WORKLOAD_IDENTITY_TOKEN`. See [Generating Multiple Tokens](https://developer.hashicorp.com/terraform/cloud-docs/workspaces/dynamic-provider-credentials/manual-generation#generating-multiple-tokens) on HCP Terraform for more details.",
 			},
+			"oidc_token_source": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(IDTokenSourceTypes...),
+					stringvalidator.AlsoRequires(path.MatchRoot("oidc_provider_name")),
+				},
+				MarkdownDescription: "Where the OIDC ID token exchanged for an access token comes from. Allowed values: " +
+					"`tfc` (Terraform Cloud workload identity token, see `tfc_credential_tag_name`), " +
+					"`github` (GitHub Actions ID token, requires the `id-token: write` permission, see `oidc_audience`), " +
+					"`env` (environment variable, e.g. a GitLab CI `id_tokens` variable, see `oidc_token_env_var`), " +
+					"`file` (token file, e.g. a Kubernetes projected service account token, see `oidc_token_file`), " +
+					"`command` (standard output of a command, see `oidc_token_command`). Default to `tfc`.",
+			},
+			"oidc_audience": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("oidc_token_source")),
+				},
+				MarkdownDescription: "Audience of the GitHub Actions ID token when `oidc_token_source` is `github`. Must match the audience of the JFrog OIDC integration.",
+			},
+			"oidc_token_env_var": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("oidc_token_source")),
+				},
+				MarkdownDescription: "Environment variable holding the ID token when `oidc_token_source` is `env`. Default to `CI_JOB_JWT_V2`, then `CI_JOB_JWT`.",
+			},
+			"oidc_token_file": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("oidc_token_source")),
+				},
+				MarkdownDescription: "Path of the file holding the ID token when `oidc_token_source` is `file`. The file is read again on each token exchange, so rotated tokens are used. Default to `/var/run/secrets/kubernetes.io/serviceaccount/token`.",
+			},
+			"oidc_token_command": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.AlsoRequires(path.MatchRoot("oidc_token_source")),
+				},
+				MarkdownDescription: "Command, followed by its arguments, which prints the ID token to standard output when `oidc_token_source` is `command`. The command is run directly, not through a shell.",
+			},
+			"jfrog_cli_server_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Server ID from the JFrog CLI configuration (`jf c add`), read from `jfrog-cli.conf.v6` in `JFROG_CLI_HOME_DIR` or `~/.jfrog`. Set to `" + JFrogCLIDefaultServerID + "` to use the default server (`jf c use`). The server URL and access token are used when they are not set with provider attributes, environment variables or OIDC. The access token is only used when the provider URL is the server URL. Encrypted JFrog CLI configurations are not supported. This can also be sourced from the `JFROG_CLI_SERVER_ID` environment variable.",
+			},
+			"retry_max_attempts": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+				MarkdownDescription: "Maximum number of attempts for an API request, including the first one. Set to `1` to disable retries. Default to `10`. This can also be sourced from the `JFROG_RETRY_MAX_ATTEMPTS` environment variable.",
+			},
+			"retry_min_wait": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validator_string.IsDuration(),
+				},
+				MarkdownDescription: "Wait time before the first retry, e.g. `500ms`. Wait times grow exponentially, with jitter, up to `retry_max_wait`. Default to `500ms`. This can also be sourced from the `JFROG_RETRY_MIN_WAIT` environment variable.",
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validator_string.IsDuration(),
+				},
+				MarkdownDescription: "Maximum wait time between retries, including waits requested by the `Retry-After` response header, e.g. `30s`. Default to `30s`. This can also be sourced from the `JFROG_RETRY_MAX_WAIT` environment variable.",
+			},
+			"retry_status_codes": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueInt64sAre(int64validator.Between(100, 599)),
+				},
+				MarkdownDescription: "HTTP response status codes which trigger a retry. Default to `429`, `502`, `503` and `504`.",
+			},
+			"retry_non_idempotent_requests": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Also retry non-idempotent (`POST` and `PATCH`) requests. Default to `false`.",
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+				MarkdownDescription: "Maximum average number of API requests per second sent by the provider, shared by all resources and data sources. Short bursts of up to one second worth of requests are allowed. `0` means no limit. Default to `0`. This can also be sourced from the `JFROG_MAX_REQUESTS_PER_SECOND` environment variable.",
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				MarkdownDescription: "Maximum number of API requests in flight at the same time, regardless of Terraform `-parallelism`. `0` means no limit. Default to `0`. This can also be sourced from the `JFROG_MAX_CONCURRENT_REQUESTS` environment variable.",
+			},
+			"log_body_limit": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				MarkdownDescription: "Number of bytes of request and response bodies included in the `jfrog_http` debug logs. `0` disables logging bodies. Sensitive fields are always masked. Default to `4096`. This can also be sourced from the `JFROG_LOG_BODY_LIMIT` environment variable.",
+			},
+			"ca_cert": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "PEM encoded CA certificate bundle, or path to a PEM file, trusted in addition to the system CAs. Use when the JFrog Platform certificate is issued by a private CA. This can also be sourced from the `JFROG_CA_CERT` environment variable.",
+			},
+			"client_cert": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+				MarkdownDescription: "PEM encoded client certificate, or path to a PEM file, presented for mutual TLS. Must be set together with `client_key`. This can also be sourced from the `JFROG_CLIENT_CERT` environment variable.",
+			},
+			"client_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert")),
+				},
+				MarkdownDescription: "PEM encoded private key of `client_cert`, or path to a PEM file. This can also be sourced from the `JFROG_CLIENT_KEY` environment variable.",
+			},
+			"tls_min_version": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("1.2", "1.3"),
+				},
+				MarkdownDescription: "Minimum TLS version. Allowed values: `1.2`, `1.3`. Default to `1.2`. This can also be sourced from the `JFROG_TLS_MIN_VERSION` environment variable.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Disable verification of the JFrog Platform server certificate. **Warning:** this exposes the access token and all API traffic to man-in-the-middle attacks, only use it for testing. Use `ca_cert` to trust a private CA instead. Default to `false`. This can also be sourced from the `JFROG_INSECURE_SKIP_VERIFY` environment variable.",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^(https?|socks5)://[^/]+`), "must be a URL with an http, https or socks5 scheme"),
+				},
+				MarkdownDescription: "URL of the proxy for all API requests, e.g. `http://proxy.example.com:3128`. Supported schemes: `http`, `https`, `socks5`. When set, the `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables are ignored by this provider instance, so provider aliases can use different proxies. Use `proxy_username` and `proxy_password` for proxy credentials.",
+			},
+			"proxy_username": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("proxy_url")),
+				},
+				MarkdownDescription: "Username for proxy authentication.",
+			},
+			"proxy_password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("proxy_username")),
+				},
+				MarkdownDescription: "Password for proxy authentication.",
+			},
+			"no_proxy": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.AlsoRequires(path.MatchRoot("proxy_url")),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				MarkdownDescription: "Hosts reached without going through `proxy_url`, using the `NO_PROXY` format: host names, domain suffixes (e.g. `.example.com`), IP addresses or CIDR ranges, optionally with a port, or `*` for all hosts.",
+			},
 		},
 	}
 }
@@ -0,0 +1,36 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jfrog/terraform-provider-shared/client"
+)
+
+func TestRetryPolicy_invalidMaxAttempts(t *testing.T) {
+	t.Setenv("JFROG_RETRY_MAX_ATTEMPTS", "three")
+
+	policy, diags := retryPolicy(context.Background(), JFrogProviderModel{})
+	if !diags.HasError() {
+		t.Fatalf("Expected an error diagnostic: got: %v", diags)
+	}
+
+	expected := client.DefaultRetryPolicy().MaxAttempts
+	if policy.MaxAttempts != expected {
+		t.Errorf("Incorrect MaxAttempts. Expected %v: got: %v", expected, policy.MaxAttempts)
+	}
+}
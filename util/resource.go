@@ -18,8 +18,8 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -28,14 +28,17 @@ type JFrogResource struct {
 	TypeName                string
 	ValidArtifactoryVersion string
 	ValidXrayVersion        string
-	DocumentEndpoint        string
-	CollectionEndpoint      string
-	CatalogHealthRequired   bool
+	// VersionConstraints holds a go-version constraint string per product, e.g. `>= 7.49, < 7.90 || >= 7.94`
+	VersionConstraints    map[Product]string
+	DocumentEndpoint      string
+	CollectionEndpoint    string
+	CatalogHealthRequired bool
+	// ImportIDFormat maps the import ID segments onto attributes, e.g. `{project_key}/{name}`
+	ImportIDFormat ImportIDFormat
+	// IdentityAttributes declares the resource identity, used with JFrogResourceWithIdentity
+	IdentityAttributes []IdentityAttribute
 }
 
-var catalogHealthOnce sync.Once
-var catalogHealthError error
-
 func (r *JFrogResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = r.TypeName
 }
@@ -50,65 +53,117 @@ func (r *JFrogResource) Configure(ctx context.Context, req resource.ConfigureReq
 }
 
 func (r JFrogResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	if r.ProviderData == nil || r.ValidArtifactoryVersion == "" {
+	resp.Diagnostics.Append(ValidateAttributeVersions(ctx, r.ProviderData, req.Config)...)
+
+	if r.ProviderData == nil {
 		return
 	}
 
-	valid, err := CheckVersion(r.ProviderData.ArtifactoryVersion, r.ValidArtifactoryVersion)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to verify Artifactory version",
-			err.Error(),
-		)
+	constraints := map[Product]string{}
+	if r.ValidArtifactoryVersion != "" {
+		constraints[ProductArtifactory] = ">= " + r.ValidArtifactoryVersion
 	}
-
-	if !valid {
-		resp.Diagnostics.AddError(
-			"Incompatible Artifactory version",
-			fmt.Sprintf("This resource is only supported by Artifactory version %s or later.", r.ValidArtifactoryVersion),
-		)
+	for product, constraint := range r.VersionConstraints {
+		constraints[product] = constraint
 	}
+
+	resp.Diagnostics.Append(ValidateVersionConstraints(r.ProviderData, constraints, "This resource")...)
 }
 
 func (r JFrogResource) ValidateXrayConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(ValidateAttributeVersions(ctx, r.ProviderData, req.Config)...)
+
 	if r.ProviderData == nil || r.ValidXrayVersion == "" {
 		return
 	}
 
-	valid, err := CheckVersion(r.ProviderData.XrayVersion, r.ValidXrayVersion)
+	resp.Diagnostics.Append(ValidateVersionConstraints(r.ProviderData, map[Product]string{ProductXray: ">= " + r.ValidXrayVersion}, "This resource")...)
+}
+
+// ValidateCatalogHealth performs catalog health check when provider data becomes available. The result is cached
+// per provider instance, see CatalogHealthChecker.
+func (r JFrogResource) ValidateCatalogHealth(providerData *ProviderMetadata) error {
+	return validateCatalogHealth(providerData)
+}
+
+func validateCatalogHealth(providerData *ProviderMetadata) error {
+	if providerData == nil {
+		log.Printf("[DEBUG] ValidateCatalogHealth: ProviderData is nil, skipping")
+		return nil
+	}
+
+	health, err := providerData.CheckCatalogHealth(context.Background())
 	if err != nil {
+		log.Printf("[ERROR] ValidateCatalogHealth: Catalog health check failed: %s", err.Error())
+		return err
+	}
+
+	if err := health.Err(); err != nil {
+		log.Printf("[ERROR] ValidateCatalogHealth: %s", err.Error())
+		return err
+	}
+
+	log.Printf("[DEBUG] ValidateCatalogHealth: Catalog health check passed successfully")
+	return nil
+}
+
+// ImportResourceState sets the attributes named by the ImportIDFormat segments from the import ID, converted to the
+// attribute types, or the identity attributes from the identity of an import block. JFrogResource doesn't implement
+// ImportState itself, so resources without import don't advertise it: embed JFrogResourceWithImportState or
+// JFrogResourceWithIdentity to support import.
+func (r *JFrogResource) ImportResourceState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" && req.Identity != nil && len(r.IdentityAttributes) > 0 {
+		r.importStateFromIdentity(ctx, req.Identity, resp)
+		return
+	}
+
+	if r.ImportIDFormat == "" {
 		resp.Diagnostics.AddError(
-			"Failed to verify Xray version",
-			err.Error(),
+			"Resource Import Not Implemented",
+			"This resource does not support import.",
 		)
+		return
 	}
 
-	if !valid {
+	values, err := r.ImportIDFormat.Parse(req.ID)
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Incompatible Xray version",
-			fmt.Sprintf("This resource is only supported by Xray version %s or later.", r.ValidXrayVersion),
+			"Unexpected Import Identifier",
+			err.Error(),
 		)
+		return
 	}
-}
 
-// ValidateCatalogHealth performs catalog health check when provider data becomes available
-func (r JFrogResource) ValidateCatalogHealth(providerData *ProviderMetadata) error {
+	for name, value := range values {
+		attrType, diags := resp.State.Schema.TypeAtPath(ctx, path.Root(name))
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			continue
+		}
 
-	if providerData == nil {
-		log.Printf("[DEBUG] ValidateCatalogHealth: ProviderData is nil, skipping")
-		return nil
+		attrValue, err := importValue(ctx, attrType, value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Invalid %s segment of import identifier %q: %s", name, req.ID, err),
+			)
+			continue
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), attrValue)...)
 	}
 
-	// Use sync.Once to ensure catalog health check is only performed once per provider process
-	catalogHealthOnce.Do(func() {
-		log.Printf("[DEBUG] ValidateCatalogHealth: Performing catalog health check")
-		catalogHealthError = CheckCatalogHealth(providerData.Client)
-		if catalogHealthError != nil {
-			log.Printf("[ERROR] ValidateCatalogHealth: Catalog health check failed: %s", catalogHealthError.Error())
-		} else {
-			log.Printf("[DEBUG] ValidateCatalogHealth: Catalog health check passed successfully")
-		}
-	})
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
+	}
+}
+
+// JFrogResourceWithImportState is a JFrogResource which can be imported with an ID of ImportIDFormat
+type JFrogResourceWithImportState struct {
+	JFrogResource
+}
 
-	return catalogHealthError
+func (r *JFrogResourceWithImportState) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.ImportResourceState(ctx, req, resp)
 }
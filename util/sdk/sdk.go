@@ -195,10 +195,9 @@ func applyTelemetry(productId, resource, verb string, f func(context.Context, *s
 		panic("attempt to apply telemetry to a nil function")
 	}
 	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-		// best effort. Go routine it
+		// best effort. Batched and sent in the background
 		if m, ok := meta.(util.ProviderMetadata); ok {
-			featureUsage := fmt.Sprintf("Resource/%s/%s", resource, verb)
-			go util.SendUsage(ctx, m.Client.R(), productId, featureUsage)
+			m.TelemetryFor(productId).Record(ctx, util.ResourceFeatureUsage(resource, verb))
 		}
 
 		return f(ctx, data, meta)
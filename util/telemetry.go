@@ -0,0 +1,181 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultTelemetryFlushDelay = 2 * time.Second
+	telemetrySendTimeout       = 10 * time.Second
+)
+
+// Telemetry batches usage features into a single `artifactory/api/system/usage` call. Features are deduplicated for
+// the life of the provider. Pending features are sent after FlushDelay, or by Flush and ShutdownTelemetry.
+type Telemetry struct {
+	FlushDelay time.Duration
+
+	client    *resty.Client
+	productId string
+	disabled  bool
+
+	mu       sync.Mutex
+	pending  map[string]struct{}
+	reported map[string]struct{}
+	timer    *time.Timer
+	inFlight sync.WaitGroup
+}
+
+type telemetryKey struct {
+	client    *resty.Client
+	productId string
+}
+
+// telemetries holds the Telemetry of each client and product until ShutdownTelemetry, including the shared ones of
+// provider metadata configured without Telemetry
+var telemetries sync.Map
+
+// NewTelemetry returns a Telemetry for the client. A disabled Telemetry drops all features.
+func NewTelemetry(restyClient *resty.Client, productId string, disabled bool) *Telemetry {
+	t := newTelemetry(restyClient, productId, disabled)
+	telemetries.Store(telemetryKey{client: restyClient, productId: productId}, t)
+	return t
+}
+
+func newTelemetry(restyClient *resty.Client, productId string, disabled bool) *Telemetry {
+	return &Telemetry{
+		FlushDelay: DefaultTelemetryFlushDelay,
+		client:     restyClient,
+		productId:  productId,
+		disabled:   disabled || restyClient == nil,
+		pending:    map[string]struct{}{},
+		reported:   map[string]struct{}{},
+	}
+}
+
+// UsageReportingDisabled returns true if the JFROG_TF_DISABLE_USAGE environment variable opts out of usage reporting
+func UsageReportingDisabled() bool {
+	return GetBoolEnvVar([]string{"JFROG_TF_DISABLE_USAGE"}, false)
+}
+
+// Record queues features which were not reported yet and schedules a flush
+func (t *Telemetry) Record(ctx context.Context, features ...string) {
+	if t == nil || t.disabled {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, feature := range features {
+		if _, ok := t.reported[feature]; ok {
+			continue
+		}
+		t.reported[feature] = struct{}{}
+		t.pending[feature] = struct{}{}
+	}
+
+	if len(t.pending) > 0 && t.timer == nil {
+		t.timer = time.AfterFunc(t.FlushDelay, func() {
+			t.Flush(context.Background())
+		})
+	}
+}
+
+// Flush sends the pending features and waits for sends in flight
+func (t *Telemetry) Flush(ctx context.Context) {
+	if t == nil || t.disabled {
+		return
+	}
+
+	t.mu.Lock()
+	if t.timer != nil {
+		t.timer.Stop()
+		t.timer = nil
+	}
+
+	features := make([]string, 0, len(t.pending))
+	for feature := range t.pending {
+		features = append(features, feature)
+	}
+	t.pending = map[string]struct{}{}
+
+	if len(features) > 0 {
+		t.inFlight.Add(1)
+	}
+	t.mu.Unlock()
+
+	if len(features) > 0 {
+		t.send(ctx, features)
+	}
+
+	t.inFlight.Wait()
+}
+
+func (t *Telemetry) send(ctx context.Context, features []string) {
+	defer t.inFlight.Done()
+
+	sort.Strings(features)
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), telemetrySendTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "Sending usage", map[string]interface{}{"features": features})
+	SendUsage(ctx, t.client.R().SetContext(ctx), t.productId, features...)
+}
+
+// ShutdownTelemetry flushes all Telemetry instances and drops them. mux.Serve calls it when the provider server
+// stops. Providers serving otherwise must call it after the serve function returns, e.g. after
+// providerserver.Serve, or the features recorded within FlushDelay of the end are lost.
+func ShutdownTelemetry(ctx context.Context) {
+	var wg sync.WaitGroup
+	telemetries.Range(func(key, value interface{}) bool {
+		wg.Add(1)
+		go func(t *Telemetry) {
+			defer wg.Done()
+			t.Flush(ctx)
+			telemetries.CompareAndDelete(key, t)
+		}(value.(*Telemetry))
+		return true
+	})
+	wg.Wait()
+}
+
+// TelemetryFor returns the Telemetry of the provider, or a shared one per client and product for provider metadata
+// configured without Telemetry, e.g. by SDKv2 providers
+func (m ProviderMetadata) TelemetryFor(productId string) *Telemetry {
+	if m.Telemetry != nil && (productId == "" || productId == m.ProductId) {
+		return m.Telemetry
+	}
+
+	if productId == "" {
+		productId = m.ProductId
+	}
+
+	key := telemetryKey{client: m.Client, productId: productId}
+	t, _ := telemetries.LoadOrStore(key, newTelemetry(m.Client, productId, UsageReportingDisabled()))
+	return t.(*Telemetry)
+}
+
+// RecordUsage records usage features with the provider's Telemetry
+func (m ProviderMetadata) RecordUsage(ctx context.Context, features ...string) {
+	m.TelemetryFor(m.ProductId).Record(ctx, features...)
+}
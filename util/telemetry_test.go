@@ -0,0 +1,169 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jfrog/terraform-provider-shared/client"
+)
+
+type usageServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []UsageStruct
+}
+
+func newUsageServer(t *testing.T) *usageServer {
+	s := &usageServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/artifactory/api/system/usage" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var usage UsageStruct
+		if err := json.NewDecoder(r.Body).Decode(&usage); err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+
+		s.mu.Lock()
+		s.requests = append(s.requests, usage)
+		s.mu.Unlock()
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *usageServer) Requests() []UsageStruct {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]UsageStruct(nil), s.requests...)
+}
+
+func TestTelemetry(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		disabled bool
+		records  [][]string
+		expected []UsageStruct
+	}{
+		"batched and deduplicated": {
+			records: [][]string{
+				{"Terraform/1.9.0"},
+				{"Resource/foo/CREATE", "Resource/foo/READ"},
+				{"Resource/foo/READ"},
+			},
+			expected: []UsageStruct{
+				{
+					ProductId: "test",
+					Features: []Feature{
+						{FeatureId: "Partner/ACC-007450"},
+						{FeatureId: "Resource/foo/CREATE"},
+						{FeatureId: "Resource/foo/READ"},
+						{FeatureId: "Terraform/1.9.0"},
+					},
+				},
+			},
+		},
+		"disabled": {
+			disabled: true,
+			records:  [][]string{{"Terraform/1.9.0"}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newUsageServer(t)
+			restyClient, err := client.Build(server.URL, "test")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			telemetry := NewTelemetry(restyClient, "test", testCase.disabled)
+			telemetry.FlushDelay = time.Hour
+			for _, features := range testCase.records {
+				telemetry.Record(context.Background(), features...)
+			}
+			telemetry.Flush(context.Background())
+			// nothing left to send
+			telemetry.Flush(context.Background())
+
+			if requests := server.Requests(); !reflect.DeepEqual(requests, testCase.expected) {
+				t.Errorf("Incorrect usage requests. Expected %v: got: %v", testCase.expected, requests)
+			}
+		})
+	}
+}
+
+func TestTelemetry_flushesAfterDelay(t *testing.T) {
+	server := newUsageServer(t)
+	restyClient, err := client.Build(server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	telemetry := NewTelemetry(restyClient, "test", false)
+	telemetry.FlushDelay = 10 * time.Millisecond
+	telemetry.Record(context.Background(), "Resource/foo/CREATE")
+	telemetry.Record(context.Background(), "Resource/foo/READ")
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(server.Requests()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	requests := server.Requests()
+	if len(requests) != 1 || len(requests[0].Features) != 3 {
+		t.Errorf("Incorrect usage requests. Expected 1 request with 3 features: got: %v", requests)
+	}
+}
+
+func TestProviderMetadata_TelemetryFor(t *testing.T) {
+	server := newUsageServer(t)
+	restyClient, err := client.Build(server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	meta := ProviderMetadata{Client: restyClient, ProductId: "test"}
+	meta.RecordUsage(context.Background(), "Resource/foo/CREATE")
+	meta.TelemetryFor("test").Record(context.Background(), "Resource/foo/CREATE")
+	meta.TelemetryFor("other").Record(context.Background(), "Resource/foo/CREATE")
+
+	ShutdownTelemetry(context.Background())
+
+	requests := server.Requests()
+	if len(requests) != 2 {
+		t.Errorf("Incorrect usage requests. Expected 2 requests, one per product: got: %v", requests)
+	}
+
+	for _, productId := range []string{"test", "other"} {
+		if _, ok := telemetries.Load(telemetryKey{client: restyClient, productId: productId}); ok {
+			t.Errorf("expected %s telemetry to be dropped after shutdown", productId)
+		}
+	}
+}
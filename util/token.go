@@ -0,0 +1,104 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-shared/client"
+)
+
+// OIDCTokenSource is a client.TokenSource for access tokens from the OIDC token exchange. When the platform
+// issues a refresh token with the access token, tokens are renewed with the refresh token flow, otherwise (or
+// when the refresh fails) the OIDC token exchange runs again.
+type OIDCTokenSource struct {
+	Client       *resty.Client
+	ProviderName string
+	// IDTokenSource provides the ID token to exchange. TFCIDTokenSource with CredentialTag is used when nil.
+	IDTokenSource IDTokenSource
+	CredentialTag string
+
+	current *client.Token
+}
+
+func (s *OIDCTokenSource) Token(ctx context.Context) (*client.Token, error) {
+	if s.current != nil && s.current.RefreshToken != "" {
+		token, err := RefreshAccessToken(ctx, s.Client, s.current.AccessToken, s.current.RefreshToken)
+		if err == nil {
+			s.current = token
+			return token, nil
+		}
+		log.Printf("[DEBUG] Failed to refresh OIDC access token, running OIDC token exchange again: %s", err)
+	}
+
+	idTokenSource := s.IDTokenSource
+	if idTokenSource == nil {
+		idTokenSource = TFCIDTokenSource{CredentialTag: s.CredentialTag}
+	}
+
+	result, err := oidcTokenExchange(ctx, s.Client, s.ProviderName, idTokenSource)
+	if err != nil {
+		return nil, err
+	}
+
+	s.current = &client.Token{
+		AccessToken:  result.AccessToken,
+		RefreshToken: result.RefreshToken,
+		Expiry:       expiry(result.ExpiresIn),
+	}
+
+	return s.current, nil
+}
+
+type refreshTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
+// RefreshAccessToken exchanges a refreshable access token and its refresh token for a new pair
+func RefreshAccessToken(ctx context.Context, restyClient *resty.Client, accessToken, refreshToken string) (*client.Token, error) {
+	var result refreshTokenResponse
+	resp, err := restyClient.R().
+		SetContext(client.WithoutAuth(ctx)).
+		SetFormData(map[string]string{
+			"grant_type":    "refresh_token",
+			"access_token":  accessToken,
+			"refresh_token": refreshToken,
+		}).
+		SetResult(&result).
+		Post("/access/api/v1/tokens")
+
+	if err := client.CheckResponse(resp, err); err != nil {
+		return nil, fmt.Errorf("failed to refresh access token: %w", err)
+	}
+
+	return &client.Token{
+		AccessToken:  result.AccessToken,
+		RefreshToken: result.RefreshToken,
+		Expiry:       expiry(result.ExpiresIn),
+	}, nil
+}
+
+func expiry(expiresIn int64) time.Time {
+	if expiresIn <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(time.Duration(expiresIn) * time.Second)
+}
@@ -27,26 +27,35 @@ import (
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-shared/client"
 )
 
-func resourceFeatureUsage(resourceName, method string) string {
+func ResourceFeatureUsage(resourceName, method string) string {
 	return fmt.Sprintf("Resource/%s/%s", resourceName, method)
 }
 
+func DataSourceFeatureUsage(dataSourceName, method string) string {
+	return fmt.Sprintf("DataSource/%s/%s", dataSourceName, method)
+}
+
 func SendUsageResourceCreate(ctx context.Context, req *resty.Request, productId, resourceName string) {
-	SendUsage(ctx, req, productId, resourceFeatureUsage(resourceName, "CREATE"))
+	SendUsage(ctx, req, productId, ResourceFeatureUsage(resourceName, "CREATE"))
 }
 
 func SendUsageResourceRead(ctx context.Context, req *resty.Request, productId, resourceName string) {
-	SendUsage(ctx, req, productId, resourceFeatureUsage(resourceName, "READ"))
+	SendUsage(ctx, req, productId, ResourceFeatureUsage(resourceName, "READ"))
 }
 
 func SendUsageResourceUpdate(ctx context.Context, req *resty.Request, productId, resourceName string) {
-	SendUsage(ctx, req, productId, resourceFeatureUsage(resourceName, "UPDATE"))
+	SendUsage(ctx, req, productId, ResourceFeatureUsage(resourceName, "UPDATE"))
 }
 
 func SendUsageResourceDelete(ctx context.Context, req *resty.Request, productId, resourceName string) {
-	SendUsage(ctx, req, productId, resourceFeatureUsage(resourceName, "DELETE"))
+	SendUsage(ctx, req, productId, ResourceFeatureUsage(resourceName, "DELETE"))
+}
+
+func SendUsageDataSourceRead(ctx context.Context, req *resty.Request, productId, dataSourceName string) {
+	SendUsage(ctx, req, productId, DataSourceFeatureUsage(dataSourceName, "READ"))
 }
 
 type Feature struct {
@@ -78,13 +87,9 @@ func SendUsage(ctx context.Context, req *resty.Request, productId string, featur
 		SetBody(usage).
 		Post("artifactory/api/system/usage")
 
-	if err != nil {
+	if err := client.CheckResponse(resp, err); err != nil {
 		tflog.Info(ctx, fmt.Sprintf("failed to send usage: %v", err))
 	}
-
-	if resp.IsError() {
-		tflog.Info(ctx, fmt.Sprintf("failed to send usage: %v", resp.String()))
-	}
 }
 
 type OIDCAccessTokenRequest struct {
@@ -95,58 +100,60 @@ type OIDCAccessTokenRequest struct {
 }
 
 type OIDCAccessTokenResponse struct {
-	AccessToken string `json:"access_token"`
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
 }
 
 // OIDCTokenExchange use TFC_WORKLOAD_IDENTITY_TOKEN env var value to exchange for a access token using
 // OIDC provider configured on JFrog platform
-func OIDCTokenExchange(ctx context.Context, client *resty.Client, providerName, credentialTag string) (string, error) {
-	if client == nil {
-		return "", fmt.Errorf("client is nil")
+func OIDCTokenExchange(ctx context.Context, restyClient *resty.Client, providerName, credentialTag string) (string, error) {
+	result, err := oidcTokenExchange(ctx, restyClient, providerName, TFCIDTokenSource{CredentialTag: credentialTag})
+	if err != nil {
+		return "", err
 	}
 
-	if providerName == "" {
-		return "", fmt.Errorf("provider name is not set")
+	return result.AccessToken, nil
+}
+
+// oidcTokenExchange exchanges the ID token from idTokenSource for an access token using the OIDC provider
+// configured on JFrog platform
+func oidcTokenExchange(ctx context.Context, restyClient *resty.Client, providerName string, idTokenSource IDTokenSource) (*OIDCAccessTokenResponse, error) {
+	if restyClient == nil {
+		return nil, fmt.Errorf("client is nil")
 	}
 
-	tfcWorkloadIdentityTokenEnvVars := []string{"TFC_WORKLOAD_IDENTITY_TOKEN"}
-	if credentialTag != "" {
-		tfcWorkloadIdentityTokenEnvVars = append(
-			tfcWorkloadIdentityTokenEnvVars,
-			fmt.Sprintf("TFC_WORKLOAD_IDENTITY_TOKEN_%s", credentialTag),
-		)
+	if providerName == "" {
+		return nil, fmt.Errorf("provider name is not set")
 	}
 
-	tfcWorkloadIdentityToken := CheckEnvVars(tfcWorkloadIdentityTokenEnvVars, "")
-	if tfcWorkloadIdentityToken == "" {
-		return "", fmt.Errorf("env var %s is not set", strings.Join(tfcWorkloadIdentityTokenEnvVars, " or "))
+	idToken, err := idTokenSource.IDToken(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	payload := OIDCAccessTokenRequest{
 		GrantType:        "urn:ietf:params:oauth:grant-type:token-exchange",
 		SubjectTokenType: "urn:ietf:params:oauth:token-type:id_token",
-		SubjectToken:     tfcWorkloadIdentityToken,
+		SubjectToken:     idToken,
 		ProviderName:     providerName,
 	}
 
 	var result OIDCAccessTokenResponse
-	response, err := client.R().
+	response, err := restyClient.R().
+		SetContext(client.WithoutAuth(ctx)).
 		SetBody(payload).
 		SetResult(&result).
 		Post("/access/api/v1/oidc/token")
 
-	if err != nil {
-		return "", err
+	if err := client.CheckResponse(response, err); err != nil {
+		return nil, fmt.Errorf("OIDC token exchange failed: %w", err)
 	}
 
-	if response.IsError() {
-		return "", fmt.Errorf("OIDC token exchange failed: %s", response.String())
-	}
-
-	return result.AccessToken, nil
+	return &result, nil
 }
 
-func CheckArtifactoryLicense(client *resty.Client, licenseTypesToCheck ...string) error {
+func CheckArtifactoryLicense(restyClient *resty.Client, licenseTypesToCheck ...string) error {
 	if len(licenseTypesToCheck) == 0 {
 		return fmt.Errorf("licenseTypesToCheck is empty")
 	}
@@ -161,16 +168,12 @@ func CheckArtifactoryLicense(client *resty.Client, licenseTypesToCheck ...string
 	}
 
 	licensesWrapper := LicensesWrapper{}
-	resp, err := client.R().
+	resp, err := restyClient.R().
 		SetResult(&licensesWrapper).
 		Get("/artifactory/api/system/license")
 
-	if err != nil {
-		return fmt.Errorf("failed to check for license. If your usage doesn't require admin permission, you can set `check_license` attribute to `false` to skip this check. %s", err.Error())
-	}
-
-	if resp.IsError() {
-		return fmt.Errorf("failed to check for license. If your usage doesn't require admin permission, you can set `check_license` attribute to `false` to skip this check. %s", resp.String())
+	if err := client.CheckResponse(resp, err); err != nil {
+		return fmt.Errorf("failed to check for license. If your usage doesn't require admin permission, you can set `check_license` attribute to `false` to skip this check. %w", err)
 	}
 
 	var licenseType string
@@ -203,7 +206,7 @@ func CheckVersion(versionToCheck string, supportedVersion string) (bool, error)
 	return v1.GreaterThanOrEqual(v2), nil
 }
 
-func CheckXrayVersion(client *resty.Client, minVersion string, customMessage string) (string, error) {
+func CheckXrayVersion(restyClient *resty.Client, minVersion string, customMessage string) (string, error) {
 	// Skip version check if disabled via environment variable
 	if GetBoolEnvVar([]string{"SKIP_XRAY_VERSION_CHECK"}, false) {
 		return "", nil
@@ -226,7 +229,7 @@ func CheckXrayVersion(client *resty.Client, minVersion string, customMessage str
 		}
 		return fmt.Errorf("xray version %s is not supported - minimum required version is %s", err.currentVersion, err.minVersion)
 	}
-	version, err := GetXrayVersion(client)
+	version, err := GetXrayVersion(restyClient)
 	if err != nil {
 		return "", fmt.Errorf("failed to get Xray version: %v", err)
 	}
@@ -243,134 +246,36 @@ func CheckXrayVersion(client *resty.Client, minVersion string, customMessage str
 	return version, nil
 }
 
-func GetArtifactoryVersion(client *resty.Client) (string, error) {
-	type ArtifactoryVersion struct {
-		Version string `json:"version"`
-	}
-
-	artifactoryVersion := ArtifactoryVersion{}
-	resp, err := client.R().
-		SetResult(&artifactoryVersion).
-		Get("/artifactory/api/system/version")
-
-	if err != nil {
-		return "", fmt.Errorf("failed to get Artifactory version. %s", err)
-	}
-
-	if resp.IsError() {
-		return "", fmt.Errorf("failed to get Artifactory version. %s", resp.String())
-	}
-
-	return artifactoryVersion.Version, nil
+func GetArtifactoryVersion(restyClient *resty.Client) (string, error) {
+	return getProductVersion(context.Background(), restyClient, ProductArtifactory)
 }
 
-func GetAccessVersion(client *resty.Client) (string, error) {
-	type AccessVersion struct {
-		Version string `json:"name"`
-	}
-
-	accessVersion := AccessVersion{}
-	resp, err := client.R().
-		SetResult(&accessVersion).
-		Get("/access/api/v1/system/version")
-
-	if err != nil {
-		return "", fmt.Errorf("failed to get Access version. %s", err)
-	}
-
-	if resp.IsError() {
-		return "", fmt.Errorf("failed to get Access version. %s", resp.String())
-	}
-
-	return accessVersion.Version, nil
+func GetAccessVersion(restyClient *resty.Client) (string, error) {
+	return getProductVersion(context.Background(), restyClient, ProductAccess)
 }
 
-func GetXrayVersion(client *resty.Client) (string, error) {
-	type XrayVersion struct {
-		Version string `json:"xray_version"`
-	}
-
-	xrayVersion := XrayVersion{}
-	resp, err := client.R().
-		SetResult(&xrayVersion).
-		Get("/xray/api/v1/system/version")
-
-	if err != nil {
-		return "", fmt.Errorf("failed to get Xray version. %s", err)
-	}
-
-	if resp.IsError() {
-		return "", fmt.Errorf("failed to get Xray version. %s", resp.String())
-	}
-
-	return xrayVersion.Version, nil
+func GetXrayVersion(restyClient *resty.Client) (string, error) {
+	return getProductVersion(context.Background(), restyClient, ProductXray)
 }
 
-func CheckCatalogHealth(client *resty.Client) error {
-	type CatalogEntitlements struct {
-		EntitledForCatalog bool `json:"entitled_for_catalog"`
-		HasCentralToken    bool `json:"has_central_token"`
-		TokenExpired       bool `json:"token_expired"`
-	}
-
-	type CatalogCentral struct {
-		CentralConnectionWorking bool `json:"central_connection_working"`
-	}
-
-	type CatalogHealthResponse struct {
-		Entitlements        CatalogEntitlements `json:"entitlements"`
-		Central             CatalogCentral      `json:"central"`
-		DbConnectionWorking bool                `json:"db_connection_working"`
-		OneModelAvailable   bool                `json:"one_model_available"`
-		Code                string              `json:"code"`
-	}
+func GetDistributionVersion(restyClient *resty.Client) (string, error) {
+	return getProductVersion(context.Background(), restyClient, ProductDistribution)
+}
 
-	catalogHealth := CatalogHealthResponse{}
-	resp, err := client.R().
-		SetResult(&catalogHealth).
-		Get("/catalog/api/v1/system/app_health")
+func GetCatalogVersion(restyClient *resty.Client) (string, error) {
+	return getProductVersion(context.Background(), restyClient, ProductCatalog)
+}
 
+func CheckCatalogHealth(restyClient *resty.Client) error {
+	health, err := GetCatalogHealth(context.Background(), restyClient)
 	if err != nil {
-		log.Printf("[DEBUG] Catalog health check failed with error: %s", err.Error())
-		return fmt.Errorf("failed to validate catalog health. %s", err)
-	}
-
-	if resp.IsError() {
-		log.Printf("[ERROR] Catalog health check returned error response: %s", resp.String())
-		return fmt.Errorf("failed to validate catalog health. %s", resp.String())
-	}
-
-	// Check if catalog is healthy
-	if catalogHealth.Code != "OK" {
-		log.Printf("[ERROR] Catalog health check failed with code: %s", catalogHealth.Code)
-		return fmt.Errorf("catalog health check failed with code: %s", catalogHealth.Code)
-	}
-
-	// Check entitlements
-	if !catalogHealth.Entitlements.EntitledForCatalog {
-		log.Printf("[ERROR] Catalog is not entitled for use")
-		return fmt.Errorf("catalog is not entitled for use")
-	}
-
-	if catalogHealth.Entitlements.TokenExpired {
-		log.Printf("[ERROR] Catalog token has expired")
-		return fmt.Errorf("catalog token has expired")
-	}
-
-	// Check connections
-	if !catalogHealth.DbConnectionWorking {
-		log.Printf("[ERROR] Catalog database connection is not working")
-		return fmt.Errorf("catalog database connection is not working")
-	}
-
-	if !catalogHealth.Central.CentralConnectionWorking {
-		log.Printf("[ERROR] Catalog central connection is not working")
-		return fmt.Errorf("catalog central connection is not working")
+		log.Printf("[ERROR] Catalog health check failed: %s", err)
+		return err
 	}
 
-	if !catalogHealth.OneModelAvailable {
-		log.Printf("[ERROR] Catalog model is not available")
-		return fmt.Errorf("catalog model is not available")
+	if err := health.Err(); err != nil {
+		log.Printf("[ERROR] %s", err)
+		return err
 	}
 
 	return nil
@@ -0,0 +1,110 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// CheckVersionConstraint checks the version against a go-version constraint string, e.g. `>= 7.49, < 7.90`.
+// Alternatives are separated by `||`, e.g. `>= 7.49, < 7.90 || >= 7.94`.
+func CheckVersionConstraint(versionToCheck string, constraint string) (bool, error) {
+	v, err := version.NewVersion(versionToCheck)
+	if err != nil {
+		return false, fmt.Errorf("could not parse version: %s", versionToCheck)
+	}
+
+	alternatives := strings.Split(constraint, "||")
+	matches := false
+	for _, alternative := range alternatives {
+		constraints, err := version.NewConstraint(strings.TrimSpace(alternative))
+		if err != nil {
+			return false, fmt.Errorf("could not parse version constraint: %s", constraint)
+		}
+
+		if constraints.Check(v) {
+			matches = true
+		}
+	}
+
+	return matches, nil
+}
+
+// ProductVersion returns the detected version of the product, or an empty string if it is unknown
+func (m ProviderMetadata) ProductVersion(product Product) string {
+	if v := m.Platform.Product(product).Version; v != "" {
+		return v
+	}
+
+	// metadata not built by platform discovery, e.g. by SDKv2 providers
+	switch product {
+	case ProductArtifactory:
+		return m.ArtifactoryVersion
+	case ProductAccess:
+		return m.AccessVersion
+	case ProductXray:
+		return m.XrayVersion
+	}
+
+	return ""
+}
+
+// ValidateVersionConstraints checks the detected product versions against the version constraints. Products with an
+// unknown version only produce a warning, as the version may not be discoverable with the provider credentials.
+func ValidateVersionConstraints(providerData *ProviderMetadata, constraints map[Product]string, subject string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if providerData == nil {
+		return diags
+	}
+
+	for _, product := range Products {
+		constraint, ok := constraints[product]
+		if !ok || constraint == "" {
+			continue
+		}
+
+		detectedVersion := providerData.ProductVersion(product)
+		if detectedVersion == "" {
+			diags.AddWarning(
+				fmt.Sprintf("Unable to verify %s version", product),
+				fmt.Sprintf("%s requires %s version %s, but the %s version could not be detected.", subject, product, constraint, product),
+			)
+			continue
+		}
+
+		valid, err := CheckVersionConstraint(detectedVersion, constraint)
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("Failed to verify %s version", product),
+				err.Error(),
+			)
+			continue
+		}
+
+		if !valid {
+			diags.AddError(
+				fmt.Sprintf("Incompatible %s version", product),
+				fmt.Sprintf("%s requires %s version %s, but version %s was detected.", subject, product, constraint, detectedVersion),
+			)
+		}
+	}
+
+	return diags
+}
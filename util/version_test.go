@@ -0,0 +1,95 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckVersionConstraint(t *testing.T) {
+	t.Parallel()
+
+	constraint := ">= 7.49, < 7.90 || >= 7.94"
+	testCases := map[string]bool{
+		"7.48.0":  false,
+		"7.49.0":  true,
+		"7.89.10": true,
+		"7.90.0":  false,
+		"7.93.2":  false,
+		"7.94.0":  true,
+		"8.0.0":   true,
+	}
+
+	for versionToCheck, expected := range testCases {
+		versionToCheck, expected := versionToCheck, expected
+		t.Run(versionToCheck, func(t *testing.T) {
+			t.Parallel()
+
+			valid, err := CheckVersionConstraint(versionToCheck, constraint)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if valid != expected {
+				t.Errorf("Incorrect version support. Expected %v: got: %v", expected, valid)
+			}
+		})
+	}
+}
+
+func TestCheckVersionConstraint_errors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := CheckVersionConstraint("not-a-version", ">= 7.49"); err == nil {
+		t.Error("expected version error, got no error")
+	}
+
+	if _, err := CheckVersionConstraint("7.49.0", ">= 7.49 || ~~ 8"); err == nil {
+		t.Error("expected constraint error, got no error")
+	}
+}
+
+func TestValidateVersionConstraints(t *testing.T) {
+	t.Parallel()
+
+	providerData := &ProviderMetadata{
+		ArtifactoryVersion: "7.80.0",
+		Platform: PlatformInfo{
+			Xray: ProductInfo{Installed: true, Version: "3.90.0"},
+		},
+	}
+
+	diags := ValidateVersionConstraints(providerData, map[Product]string{
+		ProductArtifactory:  ">= 7.77",
+		ProductXray:         ">= 3.100",
+		ProductDistribution: ">= 2.20",
+	}, "This resource")
+
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("Incorrect error count. Expected 1: got: %d", diags.ErrorsCount())
+	}
+
+	detail := diags.Errors()[0].Detail()
+	for _, expected := range []string{"Xray", ">= 3.100", "3.90.0"} {
+		if !strings.Contains(detail, expected) {
+			t.Errorf("Incorrect error detail. Expected %s in: %s", expected, detail)
+		}
+	}
+
+	if diags.WarningsCount() != 1 {
+		t.Errorf("Incorrect warning count for unknown Distribution version. Expected 1: got: %d", diags.WarningsCount())
+	}
+}
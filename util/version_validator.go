@@ -0,0 +1,306 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// VersionValidator gates an attribute of any type on a product version.
+//
+// Attribute validators have no access to provider data, so the framework Validate methods only mark the attribute:
+// the version is checked by ValidateAttributeVersions, which the resource or data source ValidateConfig calls.
+// JFrogResource and JFrogDataSource do so, resources and data sources overriding ValidateConfig must call it too.
+type VersionValidator struct {
+	Product    Product
+	Constraint string
+	// Warn reports an incompatible version as a warning instead of an error
+	Warn bool
+}
+
+var (
+	_ validator.Bool    = VersionValidator{}
+	_ validator.Dynamic = VersionValidator{}
+	_ validator.Float32 = VersionValidator{}
+	_ validator.Float64 = VersionValidator{}
+	_ validator.Int32   = VersionValidator{}
+	_ validator.Int64   = VersionValidator{}
+	_ validator.List    = VersionValidator{}
+	_ validator.Map     = VersionValidator{}
+	_ validator.Number  = VersionValidator{}
+	_ validator.Object  = VersionValidator{}
+	_ validator.Set     = VersionValidator{}
+	_ validator.String  = VersionValidator{}
+)
+
+func RequiresArtifactoryVersion(constraint string) VersionValidator {
+	return VersionValidator{Product: ProductArtifactory, Constraint: constraint}
+}
+
+func RequiresAccessVersion(constraint string) VersionValidator {
+	return VersionValidator{Product: ProductAccess, Constraint: constraint}
+}
+
+func RequiresXrayVersion(constraint string) VersionValidator {
+	return VersionValidator{Product: ProductXray, Constraint: constraint}
+}
+
+func RequiresDistributionVersion(constraint string) VersionValidator {
+	return VersionValidator{Product: ProductDistribution, Constraint: constraint}
+}
+
+func RequiresCatalogVersion(constraint string) VersionValidator {
+	return VersionValidator{Product: ProductCatalog, Constraint: constraint}
+}
+
+// WarnOnly returns a copy of the validator which warns instead of failing
+func (v VersionValidator) WarnOnly() VersionValidator {
+	v.Warn = true
+	return v
+}
+
+func (v VersionValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("requires %s version %s", v.Product, v.Constraint)
+}
+
+func (v VersionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v VersionValidator) ValidateBool(_ context.Context, _ validator.BoolRequest, _ *validator.BoolResponse) {
+}
+
+func (v VersionValidator) ValidateDynamic(_ context.Context, _ validator.DynamicRequest, _ *validator.DynamicResponse) {
+}
+
+func (v VersionValidator) ValidateFloat32(_ context.Context, _ validator.Float32Request, _ *validator.Float32Response) {
+}
+
+func (v VersionValidator) ValidateFloat64(_ context.Context, _ validator.Float64Request, _ *validator.Float64Response) {
+}
+
+func (v VersionValidator) ValidateInt32(_ context.Context, _ validator.Int32Request, _ *validator.Int32Response) {
+}
+
+func (v VersionValidator) ValidateInt64(_ context.Context, _ validator.Int64Request, _ *validator.Int64Response) {
+}
+
+func (v VersionValidator) ValidateList(_ context.Context, _ validator.ListRequest, _ *validator.ListResponse) {
+}
+
+func (v VersionValidator) ValidateMap(_ context.Context, _ validator.MapRequest, _ *validator.MapResponse) {
+}
+
+func (v VersionValidator) ValidateNumber(_ context.Context, _ validator.NumberRequest, _ *validator.NumberResponse) {
+}
+
+func (v VersionValidator) ValidateObject(_ context.Context, _ validator.ObjectRequest, _ *validator.ObjectResponse) {
+}
+
+func (v VersionValidator) ValidateSet(_ context.Context, _ validator.SetRequest, _ *validator.SetResponse) {
+}
+
+func (v VersionValidator) ValidateString(_ context.Context, _ validator.StringRequest, _ *validator.StringResponse) {
+}
+
+func (v VersionValidator) validate(ctx context.Context, providerData *ProviderMetadata, config tfsdk.Config, expression path.Expression) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	paths, d := config.PathMatches(ctx, expression)
+	diags.Append(d...)
+
+	for _, p := range paths {
+		var value attr.Value
+		diags.Append(config.GetAttribute(ctx, p, &value)...)
+		if value == nil || value.IsNull() {
+			continue
+		}
+
+		detectedVersion := providerData.ProductVersion(v.Product)
+		if detectedVersion == "" {
+			diags.AddAttributeWarning(
+				p,
+				fmt.Sprintf("Unable to verify %s version", v.Product),
+				fmt.Sprintf("Attribute %s requires %s version %s, but the %s version could not be detected.", p, v.Product, v.Constraint, v.Product),
+			)
+			continue
+		}
+
+		valid, err := CheckVersionConstraint(detectedVersion, v.Constraint)
+		if err != nil {
+			diags.AddAttributeError(p, fmt.Sprintf("Failed to verify %s version", v.Product), err.Error())
+			continue
+		}
+
+		if valid {
+			continue
+		}
+
+		summary := fmt.Sprintf("Incompatible %s version", v.Product)
+		detail := fmt.Sprintf("Attribute %s requires %s version %s, but version %s was detected.", p, v.Product, v.Constraint, detectedVersion)
+		if v.Warn {
+			diags.AddAttributeWarning(p, summary, detail+" The attribute may be ignored.")
+		} else {
+			diags.AddAttributeError(p, summary, detail)
+		}
+	}
+
+	return diags
+}
+
+// ValidateAttributeVersions checks the VersionValidators of all attributes set in the configuration, including
+// attributes of nested attributes and blocks of resource and data source schemas, against the detected product
+// versions. Nothing is checked without providerData, e.g. for terraform validate, which doesn't configure the provider.
+func ValidateAttributeVersions(ctx context.Context, providerData *ProviderMetadata, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if providerData == nil || config.Schema == nil {
+		return diags
+	}
+
+	attributes := map[string]interface{}{}
+	for name, attribute := range config.Schema.GetAttributes() {
+		attributes[name] = attribute
+	}
+	blocks := map[string]interface{}{}
+	for name, block := range config.Schema.GetBlocks() {
+		blocks[name] = block
+	}
+
+	diags.Append(validateAttributeVersions(ctx, providerData, config, path.Empty().Expression(), attributes, blocks)...)
+
+	return diags
+}
+
+func anyMap[V any](m map[string]V) map[string]interface{} {
+	result := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
+func validateAttributeVersions(ctx context.Context, providerData *ProviderMetadata, config tfsdk.Config, parent path.Expression, attributes, blocks map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	validate := func(attribute interface{}, expression path.Expression) {
+		for _, v := range versionValidators(attribute) {
+			diags.Append(v.validate(ctx, providerData, config, expression)...)
+		}
+	}
+
+	for name, attribute := range attributes {
+		expression := parent.AtName(name)
+		validate(attribute, expression)
+
+		switch a := attribute.(type) {
+		case schema.ListNestedAttribute:
+			diags.Append(validateAttributeVersions(ctx, providerData, config, expression.AtAnyListIndex(), anyMap(a.NestedObject.Attributes), nil)...)
+		case schema.SetNestedAttribute:
+			diags.Append(validateAttributeVersions(ctx, providerData, config, expression.AtAnySetValue(), anyMap(a.NestedObject.Attributes), nil)...)
+		case schema.MapNestedAttribute:
+			diags.Append(validateAttributeVersions(ctx, providerData, config, expression.AtAnyMapKey(), anyMap(a.NestedObject.Attributes), nil)...)
+		case schema.SingleNestedAttribute:
+			diags.Append(validateAttributeVersions(ctx, providerData, config, expression, anyMap(a.Attributes), nil)...)
+		case dsschema.ListNestedAttribute:
+			diags.Append(validateAttributeVersions(ctx, providerData, config, expression.AtAnyListIndex(), anyMap(a.NestedObject.Attributes), nil)...)
+		case dsschema.SetNestedAttribute:
+			diags.Append(validateAttributeVersions(ctx, providerData, config, expression.AtAnySetValue(), anyMap(a.NestedObject.Attributes), nil)...)
+		case dsschema.MapNestedAttribute:
+			diags.Append(validateAttributeVersions(ctx, providerData, config, expression.AtAnyMapKey(), anyMap(a.NestedObject.Attributes), nil)...)
+		case dsschema.SingleNestedAttribute:
+			diags.Append(validateAttributeVersions(ctx, providerData, config, expression, anyMap(a.Attributes), nil)...)
+		}
+	}
+
+	for name, block := range blocks {
+		expression := parent.AtName(name)
+		validate(block, expression)
+
+		switch b := block.(type) {
+		case schema.ListNestedBlock:
+			diags.Append(validateAttributeVersions(ctx, providerData, config, expression.AtAnyListIndex(), anyMap(b.NestedObject.Attributes), anyMap(b.NestedObject.Blocks))...)
+		case schema.SetNestedBlock:
+			diags.Append(validateAttributeVersions(ctx, providerData, config, expression.AtAnySetValue(), anyMap(b.NestedObject.Attributes), anyMap(b.NestedObject.Blocks))...)
+		case schema.SingleNestedBlock:
+			diags.Append(validateAttributeVersions(ctx, providerData, config, expression, anyMap(b.Attributes), anyMap(b.Blocks))...)
+		case dsschema.ListNestedBlock:
+			diags.Append(validateAttributeVersions(ctx, providerData, config, expression.AtAnyListIndex(), anyMap(b.NestedObject.Attributes), anyMap(b.NestedObject.Blocks))...)
+		case dsschema.SetNestedBlock:
+			diags.Append(validateAttributeVersions(ctx, providerData, config, expression.AtAnySetValue(), anyMap(b.NestedObject.Attributes), anyMap(b.NestedObject.Blocks))...)
+		case dsschema.SingleNestedBlock:
+			diags.Append(validateAttributeVersions(ctx, providerData, config, expression, anyMap(b.Attributes), anyMap(b.Blocks))...)
+		}
+	}
+
+	return diags
+}
+
+// versionValidators returns the VersionValidators of an attribute or block of any type
+func versionValidators(attribute interface{}) []VersionValidator {
+	var validators []interface{}
+
+	switch a := attribute.(type) {
+	case interface{ BoolValidators() []validator.Bool }:
+		validators = appendValidators(validators, a.BoolValidators())
+	case interface{ DynamicValidators() []validator.Dynamic }:
+		validators = appendValidators(validators, a.DynamicValidators())
+	case interface{ Float32Validators() []validator.Float32 }:
+		validators = appendValidators(validators, a.Float32Validators())
+	case interface{ Float64Validators() []validator.Float64 }:
+		validators = appendValidators(validators, a.Float64Validators())
+	case interface{ Int32Validators() []validator.Int32 }:
+		validators = appendValidators(validators, a.Int32Validators())
+	case interface{ Int64Validators() []validator.Int64 }:
+		validators = appendValidators(validators, a.Int64Validators())
+	case interface{ ListValidators() []validator.List }:
+		validators = appendValidators(validators, a.ListValidators())
+	case interface{ MapValidators() []validator.Map }:
+		validators = appendValidators(validators, a.MapValidators())
+	case interface{ NumberValidators() []validator.Number }:
+		validators = appendValidators(validators, a.NumberValidators())
+	case interface{ ObjectValidators() []validator.Object }:
+		validators = appendValidators(validators, a.ObjectValidators())
+	case interface{ SetValidators() []validator.Set }:
+		validators = appendValidators(validators, a.SetValidators())
+	case interface{ StringValidators() []validator.String }:
+		validators = appendValidators(validators, a.StringValidators())
+	}
+
+	var result []VersionValidator
+	for _, v := range validators {
+		if vv, ok := v.(VersionValidator); ok {
+			result = append(result, vv)
+		}
+	}
+
+	return result
+}
+
+func appendValidators[T any](validators []interface{}, typed []T) []interface{} {
+	for _, v := range typed {
+		validators = append(validators, v)
+	}
+	return validators
+}
@@ -0,0 +1,227 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValidateAttributeVersions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"new_flag": schema.BoolAttribute{
+				Optional:   true,
+				Validators: []validator.Bool{RequiresArtifactoryVersion(">= 7.77")},
+			},
+			"legacy_field": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{RequiresArtifactoryVersion("< 7.50").WarnOnly()},
+			},
+			"unset_field": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{RequiresArtifactoryVersion(">= 8")},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"priority": schema.Int64Attribute{
+							Optional:   true,
+							Validators: []validator.Int64{RequiresXrayVersion(">= 3.100")},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	objectType := testSchema.Type().TerraformType(ctx).(tftypes.Object)
+	ruleType := objectType.AttributeTypes["rule"].(tftypes.List).ElementType
+	raw := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"name":         tftypes.NewValue(tftypes.String, "test"),
+		"new_flag":     tftypes.NewValue(tftypes.Bool, true),
+		"legacy_field": tftypes.NewValue(tftypes.String, "value"),
+		"unset_field":  tftypes.NewValue(tftypes.String, nil),
+		"rule": tftypes.NewValue(objectType.AttributeTypes["rule"], []tftypes.Value{
+			tftypes.NewValue(ruleType, map[string]tftypes.Value{
+				"priority": tftypes.NewValue(tftypes.Number, 1),
+			}),
+		}),
+	})
+
+	r := JFrogResource{
+		ProviderData: &ProviderMetadata{
+			ArtifactoryVersion: "7.70.0",
+			XrayVersion:        "3.90.0",
+		},
+	}
+
+	req := resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: testSchema, Raw: raw}}
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(ctx, req, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 2 {
+		t.Fatalf("Incorrect error count. Expected 2: got: %d: %v", resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+	}
+
+	details := []string{}
+	for _, d := range resp.Diagnostics.Errors() {
+		details = append(details, d.Detail())
+	}
+	for _, expected := range []string{
+		"Attribute new_flag requires Artifactory version >= 7.77, but version 7.70.0 was detected.",
+		"Attribute rule[0].priority requires Xray version >= 3.100, but version 3.90.0 was detected.",
+	} {
+		if !strings.Contains(strings.Join(details, "\n"), expected) {
+			t.Errorf("Incorrect error details. Expected %s in: %v", expected, details)
+		}
+	}
+
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Incorrect warning count. Expected 1: got: %d", resp.Diagnostics.WarningsCount())
+	}
+}
+
+func TestValidateAttributeVersions_dataSource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testSchema := dsschema.Schema{
+		Attributes: map[string]dsschema.Attribute{
+			"filter": dsschema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"severity": dsschema.StringAttribute{
+							Optional:   true,
+							Validators: []validator.String{RequiresXrayVersion(">= 3.100")},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]dsschema.Block{
+			"scope": dsschema.SingleNestedBlock{
+				Attributes: map[string]dsschema.Attribute{
+					"project": dsschema.StringAttribute{
+						Optional:   true,
+						Validators: []validator.String{RequiresArtifactoryVersion(">= 7.77")},
+					},
+				},
+			},
+		},
+	}
+
+	objectType := testSchema.Type().TerraformType(ctx).(tftypes.Object)
+	filterType := objectType.AttributeTypes["filter"].(tftypes.List).ElementType
+	raw := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"filter": tftypes.NewValue(objectType.AttributeTypes["filter"], []tftypes.Value{
+			tftypes.NewValue(filterType, map[string]tftypes.Value{
+				"severity": tftypes.NewValue(tftypes.String, "High"),
+			}),
+		}),
+		"scope": tftypes.NewValue(objectType.AttributeTypes["scope"], map[string]tftypes.Value{
+			"project": tftypes.NewValue(tftypes.String, "proj"),
+		}),
+	})
+
+	d := JFrogDataSource{
+		ProviderData: &ProviderMetadata{
+			ArtifactoryVersion: "7.70.0",
+		},
+	}
+
+	req := datasource.ValidateConfigRequest{Config: tfsdk.Config{Schema: testSchema, Raw: raw}}
+	resp := &datasource.ValidateConfigResponse{}
+	d.ValidateConfig(ctx, req, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("Incorrect error count. Expected 1: got: %d: %v", resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+	}
+	expected := "Attribute scope.project requires Artifactory version >= 7.77, but version 7.70.0 was detected."
+	if detail := resp.Diagnostics.Errors()[0].Detail(); detail != expected {
+		t.Errorf("Incorrect error detail. Expected %s: got: %s", expected, detail)
+	}
+
+	// the Xray version is unknown
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("Incorrect warning count. Expected 1: got: %d: %v", resp.Diagnostics.WarningsCount(), resp.Diagnostics)
+	}
+	expected = "Attribute filter[0].severity requires Xray version >= 3.100, but the Xray version could not be detected."
+	if detail := resp.Diagnostics.Warnings()[0].Detail(); detail != expected {
+		t.Errorf("Incorrect warning detail. Expected %s: got: %s", expected, detail)
+	}
+}
+
+func TestVersionValidator_marker(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// the version is checked by ValidateAttributeVersions only, so an incompatible version is not reported here
+	v := RequiresArtifactoryVersion(">= 99")
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"marked": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{v},
+			},
+		},
+	}
+
+	objectType := testSchema.Type().TerraformType(ctx).(tftypes.Object)
+	req := validator.StringRequest{
+		Path: path.Root("marked"),
+		Config: tfsdk.Config{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"marked": tftypes.NewValue(tftypes.String, "value"),
+			}),
+		},
+		ConfigValue: types.StringValue("value"),
+	}
+
+	resp := &validator.StringResponse{}
+	v.ValidateString(ctx, req, resp)
+
+	if len(resp.Diagnostics) != 0 {
+		t.Errorf("Incorrect diagnostics. Expected none: got: %v", resp.Diagnostics)
+	}
+
+	if diags := ValidateAttributeVersions(ctx, nil, req.Config); len(diags) != 0 {
+		t.Errorf("Incorrect diagnostics without provider data. Expected none: got: %v", diags)
+	}
+}